@@ -89,6 +89,60 @@ func TestValidateCommand(t *testing.T) {
 	}
 }
 
+func TestValidateCommandGitHubFormat(t *testing.T) {
+	tmpDir := t.TempDir()
+	invalidFile := filepath.Join(tmpDir, "invalid.json")
+	if err := os.WriteFile(invalidFile, []byte(`{"irVersion": "1.0"}`), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	t.Cleanup(func() { validateFormat = "text" })
+
+	cmd := &cobra.Command{Use: "stasks"}
+	cmd.AddCommand(validateCmd)
+
+	stdout, _, err := executeCommand(cmd, "validate", "--format", "github", invalidFile)
+	if err == nil {
+		t.Error("Expected validation error")
+	}
+	if !strings.Contains(stdout, "::error file="+invalidFile+",title=project::required field is missing") {
+		t.Errorf("Expected GitHub annotation in output, got %q", stdout)
+	}
+}
+
+func TestGenerateCommandStatusFilter(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "TASKS.json")
+	validJSON := `{
+		"irVersion": "1.0",
+		"project": "Test Project",
+		"tasks": [
+			{"id": "task-1", "title": "Shipped feature", "status": "completed"},
+			{"id": "task-2", "title": "Future feature", "status": "planned"}
+		]
+	}`
+	if err := os.WriteFile(inputFile, []byte(validJSON), 0600); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	t.Cleanup(func() { genStatuses = nil })
+
+	cmd := &cobra.Command{Use: "stasks"}
+	cmd.AddCommand(generateCmd)
+
+	stdout, _, err := executeCommand(cmd, "generate", "-i", inputFile, "--status", "completed")
+	if err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	if !strings.Contains(stdout, "Shipped feature") || strings.Contains(stdout, "Future feature") {
+		t.Errorf("Expected only completed tasks in output, got\n%s", stdout)
+	}
+
+	genStatuses = nil
+	_, _, err = executeCommand(cmd, "generate", "-i", inputFile, "--status", "in_progress")
+	if err == nil || !strings.Contains(err.Error(), "unknown status: in_progress") || !strings.Contains(err.Error(), "inProgress") {
+		t.Errorf("Expected unknown status error listing allowed values, got %v", err)
+	}
+}
+
 func TestGenerateCommand(t *testing.T) {
 	// Create a temporary valid JSON file
 	tmpDir := t.TempDir()
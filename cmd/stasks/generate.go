@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/grokify/structured-tasks/renderer"
 	"github.com/grokify/structured-tasks/tasks"
@@ -12,9 +13,12 @@ import (
 var (
 	genInput           string
 	genOutput          string
+	genFormat          string
+	genStatuses        []string
 	genGroupBy         string
 	genCheckbox        bool
 	genEmoji           bool
+	genStatusLabels    bool
 	genLegend          bool
 	genNoIntro         bool
 	genTOC             bool
@@ -35,9 +39,12 @@ var generateCmd = &cobra.Command{
 func init() {
 	generateCmd.Flags().StringVarP(&genInput, "input", "i", "TASKS.json", "Input JSON file")
 	generateCmd.Flags().StringVarP(&genOutput, "output", "o", "", "Output Markdown file (default: stdout)")
+	generateCmd.Flags().StringVar(&genFormat, "format", "markdown", "Output format: markdown, confluence, slides, org, board")
+	generateCmd.Flags().StringSliceVar(&genStatuses, "status", nil, "Only include tasks with these statuses (repeatable or comma-separated)")
 	generateCmd.Flags().StringVar(&genGroupBy, "group-by", "area", "Grouping: area, type, phase, status")
 	generateCmd.Flags().BoolVar(&genCheckbox, "checkboxes", true, "Use [x]/[ ] checkbox syntax")
 	generateCmd.Flags().BoolVar(&genEmoji, "emoji", true, "Include emoji status indicators")
+	generateCmd.Flags().BoolVar(&genStatusLabels, "status-labels", false, "Use text status labels instead of emoji")
 	generateCmd.Flags().BoolVar(&genLegend, "legend", false, "Show legend table")
 	generateCmd.Flags().BoolVar(&genNoIntro, "no-intro", false, "Omit introductory paragraph")
 	generateCmd.Flags().BoolVar(&genTOC, "toc", false, "Show table of contents")
@@ -49,6 +56,18 @@ func init() {
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
+	statuses := make([]tasks.Status, len(genStatuses))
+	for i, s := range genStatuses {
+		statuses[i] = tasks.Status(s)
+		if !statuses[i].IsValid() {
+			allowed := make([]string, 0, len(tasks.StatusOrder()))
+			for _, status := range tasks.StatusOrder() {
+				allowed = append(allowed, string(status))
+			}
+			return fmt.Errorf("unknown status: %s (allowed: %s)", s, strings.Join(allowed, ", "))
+		}
+	}
+
 	r, err := tasks.ParseFile(genInput)
 	if err != nil {
 		return fmt.Errorf("failed to read input: %w", err)
@@ -64,10 +83,16 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("validation failed with %d error(s)", len(result.Errors))
 	}
 
+	// Restrict to the requested statuses
+	if len(statuses) > 0 {
+		r = r.FilterStatuses(statuses...)
+	}
+
 	// Build options
 	opts := renderer.DefaultOptions()
 	opts.UseCheckboxes = genCheckbox
 	opts.UseEmoji = genEmoji
+	opts.StatusLabels = genStatusLabels
 	opts.ShowLegend = genLegend
 	opts.ShowIntro = !genNoIntro
 	opts.ShowTOC = genTOC
@@ -91,7 +116,21 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Render
-	output := renderer.Render(r, opts)
+	var output string
+	switch genFormat {
+	case "markdown":
+		output = renderer.Render(r, opts)
+	case "confluence":
+		output = renderer.RenderConfluence(r, opts)
+	case "slides":
+		output = renderer.RenderSlides(r, opts)
+	case "org":
+		output = renderer.RenderOrg(r, opts)
+	case "board":
+		output = renderer.RenderHorizonBoard(r, opts)
+	default:
+		return fmt.Errorf("unknown format: %s", genFormat)
+	}
 
 	// Write output
 	if genOutput == "" {
@@ -104,10 +104,6 @@ func runStats(cmd *cobra.Command, args []string) error {
 	}
 
 	// Progress
-	completedPct := 0.0
-	if stats.Total > 0 {
-		completedPct = float64(stats.CompletedCount()) / float64(stats.Total) * 100
-	}
-	fmt.Fprintf(out, "\nProgress: %.0f%% complete\n", completedPct)
+	fmt.Fprintf(out, "\nProgress: %.0f%% complete\n", stats.CompletedPercent())
 	return nil
 }
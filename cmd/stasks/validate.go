@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var validateFormat string
+
 var validateCmd = &cobra.Command{
 	Use:   "validate <file>",
 	Short: "Validate a TASKS.json file",
@@ -15,6 +17,10 @@ var validateCmd = &cobra.Command{
 	RunE:  runValidate,
 }
 
+func init() {
+	validateCmd.Flags().StringVar(&validateFormat, "format", "text", "Output format: text, jsonl, github")
+}
+
 func runValidate(cmd *cobra.Command, args []string) error {
 	path := args[0]
 
@@ -25,17 +31,40 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 	result := tasks.Validate(tl)
 
+	switch validateFormat {
+	case "text":
+		printValidationText(cmd, path, tl, result)
+	case "jsonl":
+		if _, err := cmd.OutOrStdout().Write(result.ToJSONL()); err != nil {
+			return err
+		}
+	case "github":
+		fmt.Fprint(cmd.OutOrStdout(), result.ToGitHubAnnotations(path))
+	default:
+		return fmt.Errorf("unknown format: %s", validateFormat)
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("validation failed with %d error(s)", len(result.Errors))
+	}
+	return nil
+}
+
+func printValidationText(cmd *cobra.Command, path string, tl *tasks.TaskList, result tasks.ValidationResult) {
+	for _, w := range result.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "⚠️  %s: %s\n", w.Field, w.Message)
+	}
+
 	if result.Valid {
 		fmt.Fprintf(cmd.ErrOrStderr(), "✅ %s is valid\n", path)
 		fmt.Fprintf(cmd.ErrOrStderr(), "   Project: %s\n", tl.Project)
 		fmt.Fprintf(cmd.ErrOrStderr(), "   Tasks: %d\n", len(tl.Tasks))
 		fmt.Fprintf(cmd.ErrOrStderr(), "   Areas: %d\n", len(tl.Areas))
-		return nil
+		return
 	}
 
 	fmt.Fprintf(cmd.ErrOrStderr(), "❌ %s has %d error(s)\n\n", path, len(result.Errors))
 	for _, e := range result.Errors {
 		fmt.Fprintf(cmd.ErrOrStderr(), "  • %s: %s\n", e.Field, e.Message)
 	}
-	return fmt.Errorf("validation failed with %d error(s)", len(result.Errors))
 }
@@ -0,0 +1,107 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	Title   string    `xml:"title"`
+	ID      string    `xml:"id"`
+	Updated string    `xml:"updated"`
+	Link    *atomLink `xml:"link,omitempty"`
+	Summary string    `xml:"summary,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+// RenderAtom generates an Atom feed of completed tasks, for publishing a
+// "what's new" feed. feedURL is the URL the feed is served from; it must be
+// absolute, or an error wrapping tasks.ErrInvalidFormat is returned. With any
+// fragment dropped, feedURL is the feed ID, and each entry ID adds the
+// escaped task ID as the fragment. The feed author is the project name.
+// Each entry is a completed task with a completion date, from
+// Task.CompletedTime; completed tasks without one are omitted. Entries are
+// sorted by completion date, newest first, and use the task's first link, if
+// any. The feed's updated time is that of the newest entry, or the Unix
+// epoch if there are no entries.
+func RenderAtom(tl *tasks.TaskList, feedURL string) ([]byte, error) {
+	base, err := url.Parse(feedURL)
+	if err != nil || !base.IsAbs() {
+		return nil, fmt.Errorf("%w: atom feed URL must be absolute: %q", tasks.ErrInvalidFormat, feedURL)
+	}
+	base.Fragment, base.RawFragment = "", ""
+	feedID := base.String()
+
+	type completedTask struct {
+		task tasks.Task
+		at   time.Time
+	}
+	var completed []completedTask
+	for _, task := range tl.Tasks {
+		if at, ok := task.CompletedTime(); ok {
+			completed = append(completed, completedTask{task, at})
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].at.After(completed[j].at)
+	})
+
+	title := tl.Project
+	if title == "" {
+		title = "Task List"
+	}
+	updated := time.Unix(0, 0)
+	if len(completed) > 0 {
+		updated = completed[0].at
+	}
+	feed := atomFeed{
+		Title:   title,
+		ID:      feedID,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Author:  atomAuthor{Name: title},
+		Link:    atomLink{Href: feedID, Rel: "self"},
+	}
+	for _, c := range completed {
+		entryURL := *base
+		entryURL.Fragment = c.task.ID
+		entry := atomEntry{
+			Title:   c.task.Title,
+			ID:      entryURL.String(),
+			Updated: c.at.UTC().Format(time.RFC3339),
+			Summary: c.task.Description,
+		}
+		if len(c.task.Links) > 0 {
+			entry.Link = &atomLink{Href: c.task.Links[0].URL}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	out, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode atom feed: %w", err)
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
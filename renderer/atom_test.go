@@ -0,0 +1,109 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderAtom(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Widgets & Co",
+		Tasks: []tasks.Task{
+			{ID: "old", Title: "Old <feature>", Status: tasks.StatusCompleted,
+				History: []tasks.TaskEvent{{Date: "2024-01-10", Status: tasks.StatusCompleted}}},
+			{ID: "new", Title: "New feature", Status: tasks.StatusCompleted, Description: "Faster & smaller",
+				Links:   []tasks.Link{{Label: "PR", URL: "https://example.com/pr/1?a=1&b=2"}},
+				History: []tasks.TaskEvent{{Date: "2024-02-20T15:04:05Z", Status: tasks.StatusCompleted}}},
+			{ID: "undated", Title: "Undated", Status: tasks.StatusCompleted},
+			{ID: "wip", Title: "In flight", Status: tasks.StatusInProgress},
+		},
+	}
+
+	out, err := RenderAtom(tl, "https://example.com/feed.xml")
+	if err != nil {
+		t.Fatalf("RenderAtom() error = %v", err)
+	}
+
+	var feed struct {
+		Title   string `xml:"title"`
+		Updated string `xml:"updated"`
+		Author  string `xml:"author>name"`
+		Entries []struct {
+			Title   string `xml:"title"`
+			ID      string `xml:"id"`
+			Updated string `xml:"updated"`
+			Summary string `xml:"summary"`
+			Link    struct {
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(out, &feed); err != nil {
+		t.Fatalf("RenderAtom() produced invalid XML: %v\n%s", err, out)
+	}
+
+	if feed.Title != "Widgets & Co" || feed.Updated != "2024-02-20T15:04:05Z" {
+		t.Errorf("feed title/updated = %q, %q", feed.Title, feed.Updated)
+	}
+	if feed.Author != "Widgets & Co" {
+		t.Errorf("feed author = %q, want project name", feed.Author)
+	}
+	if len(feed.Entries) != 2 {
+		t.Fatalf("RenderAtom() produced %d entries, want 2\n%s", len(feed.Entries), out)
+	}
+	first, second := feed.Entries[0], feed.Entries[1]
+	if first.ID != "https://example.com/feed.xml#new" || first.Summary != "Faster & smaller" || first.Link.Href != "https://example.com/pr/1?a=1&b=2" {
+		t.Errorf("first entry = %+v", first)
+	}
+	if second.Title != "Old <feature>" || second.Updated != "2024-01-10T00:00:00Z" {
+		t.Errorf("second entry = %+v", second)
+	}
+	if !strings.Contains(string(out), `xmlns="http://www.w3.org/2005/Atom"`) {
+		t.Error("RenderAtom() should declare the Atom namespace")
+	}
+}
+
+func TestRenderAtomRequiresAbsoluteURL(t *testing.T) {
+	tl := &tasks.TaskList{IRVersion: "1.0", Project: "p"}
+	for _, feedURL := range []string{"", "feed.xml", "/feed.xml", "http://[::1"} {
+		if _, err := RenderAtom(tl, feedURL); !errors.Is(err, tasks.ErrInvalidFormat) {
+			t.Errorf("RenderAtom(%q) error = %v, want ErrInvalidFormat", feedURL, err)
+		}
+	}
+}
+
+func TestRenderAtomEntryIDs(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "p",
+		Tasks: []tasks.Task{
+			{ID: "task one#2", Title: "Spaced", Status: tasks.StatusCompleted,
+				History: []tasks.TaskEvent{{Date: "2024-01-10", Status: tasks.StatusCompleted}}},
+		},
+	}
+
+	out, err := RenderAtom(tl, "https://example.com/feed.xml#latest")
+	if err != nil {
+		t.Fatalf("RenderAtom() error = %v", err)
+	}
+	var feed struct {
+		ID      string `xml:"id"`
+		Entries []struct {
+			ID string `xml:"id"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(out, &feed); err != nil {
+		t.Fatalf("RenderAtom() produced invalid XML: %v", err)
+	}
+	if feed.ID != "https://example.com/feed.xml" {
+		t.Errorf("feed ID = %q, want feed URL without fragment", feed.ID)
+	}
+	if len(feed.Entries) != 1 || feed.Entries[0].ID != "https://example.com/feed.xml#task%20one%232" {
+		t.Errorf("entry IDs = %+v, want escaped task ID fragment", feed.Entries)
+	}
+}
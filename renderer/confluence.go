@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// RenderConfluence generates Atlassian Confluence storage format (XHTML) from
+// a TaskList, for publishing through the Confluence REST API. Tasks are
+// grouped into sections according to opts.GroupBy, each rendered as a table
+// with statuses shown using the Confluence status macro. Markdown-specific
+// options such as checkboxes, emoji, and the table of contents are ignored.
+func RenderConfluence(tl *tasks.TaskList, opts Options) string {
+	var sb strings.Builder
+
+	if tl.Project != "" {
+		fmt.Fprintf(&sb, "<p><strong>Project:</strong> %s</p>\n", html.EscapeString(tl.Project))
+	}
+
+	for _, section := range groupSections(tl, opts) {
+		fmt.Fprintf(&sb, "<h2>%s</h2>\n", html.EscapeString(section.Title))
+		sb.WriteString("<table>\n<tbody>\n")
+		sb.WriteString("<tr><th>Task</th><th>Status</th><th>Description</th></tr>\n")
+		for _, task := range section.Tasks {
+			renderConfluenceRow(&sb, tl, task)
+		}
+		sb.WriteString("</tbody>\n</table>\n")
+	}
+
+	return sb.String()
+}
+
+func renderConfluenceRow(sb *strings.Builder, tl *tasks.TaskList, task tasks.Task) {
+	sb.WriteString("<tr>")
+	fmt.Fprintf(sb, "<td><strong>%s</strong></td>", html.EscapeString(task.Title))
+	fmt.Fprintf(sb, "<td>%s</td>", confluenceStatusMacro(tl.GetStatusLabel(task.Status), ConfluenceStatusColor(task.Status)))
+
+	sb.WriteString("<td>")
+	if task.Description != "" {
+		fmt.Fprintf(sb, "<p>%s</p>", html.EscapeString(task.Description))
+	}
+	if len(task.Subtasks) > 0 {
+		sb.WriteString("<ac:task-list>")
+		for _, subtask := range task.Subtasks {
+			status := "incomplete"
+			if subtask.Completed {
+				status = "complete"
+			}
+			fmt.Fprintf(sb, "<ac:task><ac:task-status>%s</ac:task-status><ac:task-body>%s</ac:task-body></ac:task>",
+				status, html.EscapeString(subtask.Description))
+		}
+		sb.WriteString("</ac:task-list>")
+	}
+	if len(task.Links) > 0 {
+		sb.WriteString("<ul>")
+		for _, link := range task.Links {
+			fmt.Fprintf(sb, `<li><a href="%s">%s</a></li>`, html.EscapeString(link.URL), html.EscapeString(link.Label))
+		}
+		sb.WriteString("</ul>")
+	}
+	sb.WriteString("</td>")
+	sb.WriteString("</tr>\n")
+}
+
+// confluenceStatusMacro returns a Confluence status macro with the given
+// title and colour.
+func confluenceStatusMacro(title, colour string) string {
+	return `<ac:structured-macro ac:name="status">` +
+		`<ac:parameter ac:name="colour">` + colour + `</ac:parameter>` +
+		`<ac:parameter ac:name="title">` + html.EscapeString(title) + `</ac:parameter>` +
+		`</ac:structured-macro>`
+}
+
+// ConfluenceStatusColor returns the Confluence status macro colour for a status.
+func ConfluenceStatusColor(status tasks.Status) string {
+	switch status {
+	case tasks.StatusCompleted:
+		return "Green"
+	case tasks.StatusInProgress:
+		return "Yellow"
+	case tasks.StatusBlocked:
+		return "Red"
+	case tasks.StatusPlanned:
+		return "Blue"
+	default:
+		return "Grey"
+	}
+}
@@ -0,0 +1,71 @@
+package renderer
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderConfluence(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "R&D <Tools>",
+		Areas:     []tasks.Area{{ID: "core", Name: "Core"}},
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Parse \"quoted\" input", Status: tasks.StatusInProgress, Area: "core",
+				Description: "Handle a < b & c",
+				Subtasks:    []tasks.Subtask{{Description: "Lexer", Completed: true}},
+				Links:       []tasks.Link{{Label: "Spec", URL: "https://example.com/?a=1&b=2"}}},
+			{ID: "2", Title: "Docs", Status: tasks.StatusCompleted},
+		},
+	}
+
+	out := RenderConfluence(tl, DefaultOptions())
+
+	for _, want := range []string{
+		"<strong>Project:</strong> R&amp;D &lt;Tools&gt;",
+		"<h2>Core</h2>",
+		"<h2>Other</h2>",
+		`<ac:parameter ac:name="colour">Yellow</ac:parameter><ac:parameter ac:name="title">In Progress</ac:parameter>`,
+		`<ac:parameter ac:name="colour">Green</ac:parameter>`,
+		"Parse &#34;quoted&#34; input",
+		"Handle a &lt; b &amp; c",
+		"<ac:task-status>complete</ac:task-status><ac:task-body>Lexer</ac:task-body>",
+		`href="https://example.com/?a=1&amp;b=2"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("RenderConfluence() missing %q\n%s", want, out)
+		}
+	}
+
+	// The output must be well-formed XML once wrapped in a root element
+	// declaring the ac namespace.
+	doc := `<root xmlns:ac="http://atlassian.com/content">` + out + `</root>`
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		if _, err := dec.Token(); err != nil {
+			if err != io.EOF {
+				t.Fatalf("RenderConfluence() produced malformed XML: %v", err)
+			}
+			break
+		}
+	}
+}
+
+func TestRenderConfluenceHideCompleted(t *testing.T) {
+	tl := &tasks.TaskList{
+		Project: "test",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Done", Status: tasks.StatusCompleted},
+		},
+	}
+
+	opts := DefaultOptions()
+	opts.ShowCompleted = false
+	if out := RenderConfluence(tl, opts); strings.Contains(out, "<table>") {
+		t.Errorf("RenderConfluence() with ShowCompleted=false should omit empty sections, got\n%s", out)
+	}
+}
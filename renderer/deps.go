@@ -3,6 +3,7 @@ package renderer
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"github.com/grokify/structured-tasks/tasks"
@@ -75,7 +76,7 @@ func RenderMermaid(w io.Writer, tl *tasks.TaskList, deps DepsResult) {
 
 // RenderDOT renders a dependency graph in Graphviz DOT format.
 func RenderDOT(w io.Writer, tl *tasks.TaskList, deps DepsResult) {
-	fmt.Fprintf(w, "digraph \"%s\" {\n", tl.Project)
+	fmt.Fprintf(w, "digraph \"%s\" {\n", sanitizeDOT(tl.Project))
 	fmt.Fprintln(w, "    rankdir=LR;")
 	fmt.Fprintln(w, "    node [shape=box];")
 	fmt.Fprintln(w)
@@ -87,7 +88,7 @@ func RenderDOT(w io.Writer, tl *tasks.TaskList, deps DepsResult) {
 			if !seen[id] {
 				task := deps.TaskMap[id]
 				color := StatusColor(task.Status)
-				fmt.Fprintf(w, "    %s [label=\"%s\" color=\"%s\"];\n", id, sanitizeDOT(task.Title), color)
+				fmt.Fprintf(w, "    %s [label=\"%s\" color=\"%s\"];\n", dotID(id), sanitizeDOT(task.Title), color)
 				seen[id] = true
 			}
 		}
@@ -97,9 +98,21 @@ func RenderDOT(w io.Writer, tl *tasks.TaskList, deps DepsResult) {
 
 	// Define edges
 	for _, e := range deps.Edges {
-		fmt.Fprintf(w, "    %s -> %s;\n", e.From, e.To)
+		fmt.Fprintf(w, "    %s -> %s;\n", dotID(e.From), dotID(e.To))
 	}
 
+	fmt.Fprintln(w)
+
+	// Legend mapping colors to statuses
+	legend := tl.GetLegend()
+	fmt.Fprintln(w, "    subgraph cluster_legend {")
+	fmt.Fprintln(w, "        label=\"Legend\";")
+	for _, status := range tasks.StatusOrder() {
+		fmt.Fprintf(w, "        \"legend_%s\" [label=\"%s\" color=\"%s\"];\n",
+			status, sanitizeDOT(legend[status].Description), StatusColor(status))
+	}
+	fmt.Fprintln(w, "    }")
+
 	fmt.Fprintln(w, "}")
 }
 
@@ -111,6 +124,8 @@ func StatusShape(status tasks.Status) [2]string {
 		return [2]string{"([", "])"} // Stadium/rounded
 	case tasks.StatusInProgress:
 		return [2]string{"{{", "}}"} // Hexagon
+	case tasks.StatusBlocked:
+		return [2]string{">", "]"} // Asymmetric/flag
 	case tasks.StatusPlanned:
 		return [2]string{"[", "]"} // Rectangle
 	default:
@@ -125,6 +140,8 @@ func StatusColor(status tasks.Status) string {
 		return "green"
 	case tasks.StatusInProgress:
 		return "orange"
+	case tasks.StatusBlocked:
+		return "red"
 	case tasks.StatusPlanned:
 		return "blue"
 	default:
@@ -142,6 +159,21 @@ func sanitizeMermaid(s string) string {
 
 // sanitizeDOT escapes special characters for DOT labels.
 func sanitizeDOT(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
 	s = strings.ReplaceAll(s, "\"", "\\\"")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
 	return s
 }
+
+// dotIDPattern matches IDs that are valid unquoted DOT identifiers.
+var dotIDPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// dotID returns a DOT node ID, quoting it when it is not a plain identifier
+// (e.g., IDs containing dashes).
+func dotID(id string) string {
+	if dotIDPattern.MatchString(id) {
+		return id
+	}
+	return "\"" + sanitizeDOT(id) + "\""
+}
@@ -126,6 +126,7 @@ func TestStatusShape(t *testing.T) {
 	}{
 		{tasks.StatusCompleted, [2]string{"([", "])"}},
 		{tasks.StatusInProgress, [2]string{"{{", "}}"}},
+		{tasks.StatusBlocked, [2]string{">", "]"}},
 		{tasks.StatusPlanned, [2]string{"[", "]"}},
 		{tasks.StatusFuture, [2]string{"((", "))"}},
 		{"unknown", [2]string{"((", "))"}},
@@ -146,6 +147,7 @@ func TestStatusColor(t *testing.T) {
 	}{
 		{tasks.StatusCompleted, "green"},
 		{tasks.StatusInProgress, "orange"},
+		{tasks.StatusBlocked, "red"},
 		{tasks.StatusPlanned, "blue"},
 		{tasks.StatusFuture, "gray"},
 		{"unknown", "gray"},
@@ -184,6 +186,8 @@ func TestSanitizeDOT(t *testing.T) {
 	}{
 		{"Simple text", "Simple text"},
 		{`Text with "quotes"`, `Text with \"quotes\"`},
+		{"Line one\nLine two", `Line one\nLine two`},
+		{`Back\slash`, `Back\\slash`},
 	}
 
 	for _, tt := range tests {
@@ -193,3 +197,27 @@ func TestSanitizeDOT(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderDOTLegendAndQuotedIDs(t *testing.T) {
+	tl := &tasks.TaskList{
+		Project: "test-project",
+		Tasks: []tasks.Task{
+			{ID: "task-1", Title: "First Task", Status: tasks.StatusCompleted},
+			{ID: "task-2", Title: "Second Task", Status: tasks.StatusPlanned, DependsOn: []string{"task-1"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	RenderDOT(&buf, tl, BuildDependencyGraph(tl))
+	output := buf.String()
+
+	if !strings.Contains(output, `"task-1" -> "task-2";`) {
+		t.Error("expected quoted IDs for IDs containing dashes")
+	}
+	if !strings.Contains(output, "subgraph cluster_legend") {
+		t.Error("expected legend subgraph")
+	}
+	if !strings.Contains(output, `"legend_completed" [label="Completed" color="green"];`) {
+		t.Error("expected legend entry for completed status")
+	}
+}
@@ -0,0 +1,52 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// RenderHorizonBoard generates a Markdown table with Now, Next, and Later
+// columns, listing each task under its horizon in task order, prefixed with
+// its status label or emoji as selected by opts.StatusLabels and
+// opts.UseEmoji. Tasks without a valid horizon are omitted,
+// as are completed tasks unless opts.ShowCompleted is set.
+func RenderHorizonBoard(tl *tasks.TaskList, opts Options) string {
+	byHorizon := tl.TasksByHorizon()
+	horizons := tasks.Horizons()
+	columns := make([][]string, len(horizons))
+	rows := 0
+	for i, horizon := range horizons {
+		for _, task := range byHorizon[horizon] {
+			if task.Status == tasks.StatusCompleted && !opts.ShowCompleted {
+				continue
+			}
+			cell := prefixStatus(tl, task.Title, task.Status, opts)
+			columns[i] = append(columns[i], tableCell(cell))
+		}
+		rows = max(rows, len(columns[i]))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("| Now | Next | Later |\n")
+	sb.WriteString("|-----|------|-------|\n")
+	for row := 0; row < rows; row++ {
+		sb.WriteString("|")
+		for _, column := range columns {
+			cell := ""
+			if row < len(column) {
+				cell = column[row]
+			}
+			sb.WriteString(" " + cell + " |")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// tableCell escapes pipes and flattens newlines so s fits in a Markdown
+// table cell.
+func tableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,45 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderHorizonBoard(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Tasks: []tasks.Task{
+			{ID: "a", Title: "Auth", Status: tasks.StatusInProgress, Horizon: tasks.HorizonNow},
+			{ID: "b", Title: "Search | filters", Status: tasks.StatusPlanned, Horizon: tasks.HorizonNext},
+			{ID: "c", Title: "Billing", Status: tasks.StatusPlanned, Horizon: tasks.HorizonNow},
+			{ID: "d", Title: "Done", Status: tasks.StatusCompleted, Horizon: tasks.HorizonNow},
+			{ID: "e", Title: "Unplanned", Status: tasks.StatusFuture},
+		},
+	}
+
+	want := "| Now | Next | Later |\n" +
+		"|-----|------|-------|\n" +
+		"| 🚧 Auth | 📋 Search \\| filters |  |\n" +
+		"| 📋 Billing |  |  |\n"
+	opts := DefaultOptions()
+	opts.ShowCompleted = false
+	if got := RenderHorizonBoard(tl, opts); got != want {
+		t.Errorf("RenderHorizonBoard() =\n%s\nwant:\n%s", got, want)
+	}
+
+	if got := RenderHorizonBoard(tl, DefaultOptions()); !strings.Contains(got, "| ✅ Done |  |  |") {
+		t.Errorf("RenderHorizonBoard() with ShowCompleted should list completed tasks:\n%s", got)
+	}
+
+	opts.UseEmoji = false
+	if got := RenderHorizonBoard(tl, opts); !strings.Contains(got, "| Auth | Search \\| filters |  |") {
+		t.Errorf("RenderHorizonBoard() without emoji =\n%s", got)
+	}
+
+	opts.StatusLabels = true
+	if got := RenderHorizonBoard(tl, opts); !strings.Contains(got, "| [In Progress] Auth | [Planned] Search \\| filters |  |") {
+		t.Errorf("RenderHorizonBoard() with StatusLabels =\n%s", got)
+	}
+}
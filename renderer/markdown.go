@@ -2,16 +2,16 @@ package renderer
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"regexp"
 	"sort"
 	"strings"
 
-	"github.com/grokify/structured-changelog/changelog"
 	"github.com/grokify/structured-tasks/tasks"
 )
 
-// slugify converts a heading to a GitHub-flavored markdown anchor.
+// slugify converts text to a compact anchor ID for explicit <a id> anchors.
 func slugify(s string) string {
 	s = strings.ToLower(s)
 	s = strings.ReplaceAll(s, " ", "-")
@@ -49,6 +49,7 @@ func renderSectionHeading(sb *strings.Builder, title string, project string, opt
 // tocEntry represents an entry in the table of contents.
 type tocEntry struct {
 	Title     string
+	Heading   string // heading text GitHub sees, for sections
 	Slug      string
 	Count     int
 	Completed int
@@ -67,6 +68,9 @@ func Render(tl *tasks.TaskList, opts Options) string {
 		fmt.Fprintf(&sb, "**Project:** %s\n\n", tl.Project)
 	}
 
+	// Metadata
+	renderMetadata(&sb, tl, opts)
+
 	// Intro text
 	if opts.ShowIntro {
 		intro := opts.IntroText
@@ -84,34 +88,44 @@ func Render(tl *tasks.TaskList, opts Options) string {
 		}
 	}
 
-	// Table of Contents
-	if opts.ShowTOC {
-		renderTOC(&sb, tl, opts)
-		if opts.HorizontalRules {
-			sb.WriteString("---\n\n")
-		}
-	}
+	// Everything after the TOC is rendered first so the TOC can link to
+	// the anchors GitHub assigns to the headings actually emitted.
+	var legend, body strings.Builder
 
 	// Legend
 	if opts.ShowLegend {
-		renderLegend(&sb, tl)
+		renderLegend(&legend, tl)
 		if opts.HorizontalRules {
-			sb.WriteString("---\n\n")
+			legend.WriteString("---\n\n")
 		}
 	}
 
 	// Main content grouped by strategy
 	switch opts.GroupBy {
 	case GroupByPhase:
-		renderByPhase(&sb, tl, opts)
+		renderByPhase(&body, tl, opts)
 	case GroupByStatus:
-		renderByStatus(&sb, tl, opts)
+		renderByStatus(&body, tl, opts)
 	case GroupByType:
-		renderByType(&sb, tl, opts)
+		renderByType(&body, tl, opts)
 	default:
-		renderByArea(&sb, tl, opts)
+		renderByArea(&body, tl, opts)
+	}
+
+	// Table of Contents
+	if opts.ShowTOC {
+		preceding := parseHeadings(sb.String())
+		preceding = append(preceding, heading{Level: 2, Text: "Table of Contents"})
+		preceding = append(preceding, parseHeadings(legend.String())...)
+		renderTOC(&sb, tl, opts, preceding, parseHeadings(body.String()))
+		if opts.HorizontalRules {
+			sb.WriteString("---\n\n")
+		}
 	}
 
+	sb.WriteString(legend.String())
+	sb.WriteString(body.String())
+
 	return strings.TrimRight(sb.String(), "\n") + "\n"
 }
 
@@ -121,6 +135,20 @@ func RenderToFile(path string, tl *tasks.TaskList, opts Options) error {
 	return os.WriteFile(path, []byte(content), 0600)
 }
 
+// renderMetadata writes the selected metadata keys as a list.
+func renderMetadata(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
+	wrote := false
+	for _, key := range opts.MetadataKeys {
+		if value, ok := tl.Metadata[key]; ok {
+			fmt.Fprintf(sb, "- **%s:** %s\n", key, value)
+			wrote = true
+		}
+	}
+	if wrote {
+		sb.WriteString("\n")
+	}
+}
+
 func renderLegend(sb *strings.Builder, tl *tasks.TaskList) {
 	sb.WriteString("## Legend\n\n")
 	sb.WriteString("| Status | Description |\n")
@@ -134,21 +162,29 @@ func renderLegend(sb *strings.Builder, tl *tasks.TaskList) {
 	sb.WriteString("\n")
 }
 
-// statusSortOrder returns the sort order for a status.
-// In-progress first, then planned, then future, then completed at the bottom.
-func statusSortOrder(s tasks.Status) int {
-	switch s {
-	case tasks.StatusInProgress:
-		return 0
-	case tasks.StatusPlanned:
-		return 1
-	case tasks.StatusFuture:
-		return 2
-	case tasks.StatusCompleted:
-		return 3
-	default:
-		return 4
+// statusIndicator returns the status text label when opts.StatusLabels is
+// set, otherwise the status emoji when opts.UseEmoji is set, otherwise "".
+func statusIndicator(tl *tasks.TaskList, status tasks.Status, opts Options) string {
+	switch {
+	case opts.StatusLabels:
+		return tl.GetStatusLabel(status)
+	case opts.UseEmoji:
+		return tl.GetStatusEmoji(status)
 	}
+	return ""
+}
+
+// prefixStatus prefixes title with the task's status indicator: a bracketed
+// label or an emoji, per statusIndicator.
+func prefixStatus(tl *tasks.TaskList, title string, status tasks.Status, opts Options) string {
+	indicator := statusIndicator(tl, status, opts)
+	switch {
+	case indicator == "":
+		return title
+	case opts.StatusLabels:
+		return "[" + indicator + "] " + title
+	}
+	return indicator + " " + title
 }
 
 // isPhaseComplete returns true if all tasks in a phase are completed.
@@ -170,8 +206,6 @@ func renderOverviewTable(sb *strings.Builder, tl *tasks.TaskList, opts Options)
 	sb.WriteString("| Phase | Task | Status | Area |\n")
 	sb.WriteString("|-------|------|--------|------|\n")
 
-	legend := tl.GetLegend()
-
 	// Build area name lookup
 	areaNames := make(map[string]string)
 	for _, area := range tl.Areas {
@@ -192,10 +226,11 @@ func renderOverviewTable(sb *strings.Builder, tl *tasks.TaskList, opts Options)
 		}
 	}
 
-	// Sort tasks: by phase first, then by status (completed at bottom), then by title
+	// Sort tasks: by phase first, then by status (completed at bottom), then by title.
+	// Ties keep their position in the Tasks array.
 	sorted := make([]tasks.Task, len(tl.Tasks))
 	copy(sorted, tl.Tasks)
-	sort.Slice(sorted, func(i, j int) bool {
+	sort.SliceStable(sorted, func(i, j int) bool {
 		// Phase first (0 = unphased goes last)
 		iPhase := sorted[i].Phase
 		jPhase := sorted[j].Phase
@@ -209,8 +244,8 @@ func renderOverviewTable(sb *strings.Builder, tl *tasks.TaskList, opts Options)
 			return iPhase < jPhase
 		}
 		// Within same phase, sort by status (completed at bottom)
-		iOrder := statusSortOrder(sorted[i].Status)
-		jOrder := statusSortOrder(sorted[j].Status)
+		iOrder := sorted[i].Status.Order()
+		jOrder := sorted[j].Status.Order()
 		if iOrder != jOrder {
 			return iOrder < jOrder
 		}
@@ -228,13 +263,9 @@ func renderOverviewTable(sb *strings.Builder, tl *tasks.TaskList, opts Options)
 			continue
 		}
 
-		// Status emoji
-		status := ""
-		if opts.UseEmoji {
-			if entry, ok := legend[task.Status]; ok {
-				status = entry.Emoji
-			}
-		} else {
+		// Status emoji or label
+		status := statusIndicator(tl, task.Status, opts)
+		if !opts.StatusLabels && !opts.UseEmoji {
 			status = string(task.Status)
 		}
 
@@ -251,24 +282,28 @@ func renderOverviewTable(sb *strings.Builder, tl *tasks.TaskList, opts Options)
 		}
 
 		// Task title with anchor link
-		titleLink := fmt.Sprintf("[%s](#%s)", task.Title, taskSlug(task))
+		titleLink := fmt.Sprintf("[%s](#%s)", linkLabel(task.Title), taskSlug(task))
 
 		fmt.Fprintf(sb, "| %s | %s | %s | %s |\n", phase, titleLink, status, areaName)
 	}
 	sb.WriteString("\n")
 }
 
-func renderTOC(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
+// renderTOC writes the table of contents. preceding and body are the
+// document's headings before and within the grouped sections, in order;
+// section anchors are taken from body.
+func renderTOC(sb *strings.Builder, tl *tasks.TaskList, opts Options, preceding, body []heading) {
 	sb.WriteString("## Table of Contents\n\n")
 
 	entries := buildTOCEntries(tl, opts)
+	assignSectionSlugs(entries, preceding, body)
 
 	for _, entry := range entries {
-		fmt.Fprintf(sb, "- [%s (%d/%d)](#%s)\n", entry.Title, entry.Completed, entry.Count, entry.Slug)
+		fmt.Fprintf(sb, "- [%s (%d/%d)](#%s)\n", linkLabel(entry.Title), entry.Completed, entry.Count, entry.Slug)
 
 		if opts.TOCDepth >= 2 {
 			for _, task := range entry.Tasks {
-				fmt.Fprintf(sb, "  - [%s](#%s)\n", task.Title, task.Slug)
+				fmt.Fprintf(sb, "  - [%s](#%s)\n", linkLabel(task.Title), task.Slug)
 			}
 		}
 	}
@@ -303,6 +338,27 @@ func countCompleted(taskList []tasks.Task) int {
 	return count
 }
 
+// assignSectionSlugs sets each entry's Slug to the GitHub anchor of its
+// section heading. Every heading is slugged in document order, so
+// duplicates get the same -1, -2 suffixes GitHub assigns; entries are
+// matched, in order, to level-2 body headings with the same text.
+func assignSectionSlugs(entries []tocEntry, preceding, body []heading) {
+	slugs := newSlugger()
+	for _, h := range preceding {
+		slugs.Slug(h.Text)
+	}
+	next := 0
+	for _, h := range body {
+		slug := slugs.Slug(h.Text)
+		if next < len(entries) && h.Level == 2 && h.Text == entries[next].Heading {
+			entries[next].Slug = slug
+			next++
+		}
+	}
+}
+
+// buildTOCEntries returns the TOC sections in render order. Section slugs
+// are filled in by assignSectionSlugs.
 func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 	var entries []tocEntry
 
@@ -316,7 +372,7 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 			}
 			entry := tocEntry{
 				Title:     area.Name,
-				Slug:      slugify(area.Name),
+				Heading:   sectionHeadingText(area.Name, opts),
 				Count:     len(areaTasks),
 				Completed: countCompleted(areaTasks),
 			}
@@ -345,9 +401,13 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 				continue
 			}
 			title := legend[status].Description
+			header := title
+			if opts.UseEmoji && !opts.StatusLabels {
+				header = legend[status].Emoji + " " + header
+			}
 			entry := tocEntry{
 				Title:     title,
-				Slug:      slugify(title),
+				Heading:   sectionHeadingText(header, opts),
 				Count:     len(statusTasks),
 				Completed: countCompleted(statusTasks),
 			}
@@ -375,7 +435,7 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 			title := fmt.Sprintf("Phase %d", phase)
 			entry := tocEntry{
 				Title:     title,
-				Slug:      slugify(title),
+				Heading:   sectionHeadingText(title, opts),
 				Count:     len(phaseTasks),
 				Completed: countCompleted(phaseTasks),
 			}
@@ -395,7 +455,7 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 		if phaseTasks := tasksByPhase[0]; len(phaseTasks) > 0 {
 			entry := tocEntry{
 				Title:     "Unphased",
-				Slug:      "unphased",
+				Heading:   sectionHeadingText("Unphased", opts),
 				Count:     len(phaseTasks),
 				Completed: countCompleted(phaseTasks),
 			}
@@ -404,16 +464,11 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 
 	case GroupByType:
 		tasksByType := tl.TasksByType()
-		registry := changelog.DefaultRegistry
-		allTypes := registry.All()
-		for _, ct := range allTypes {
-			typeTasks := tasksByType[ct.Name]
-			if len(typeTasks) == 0 {
-				continue
-			}
+		for _, name := range typeSectionNames(tasksByType) {
+			typeTasks := tasksByType[name]
 			entry := tocEntry{
-				Title:     ct.Name,
-				Slug:      slugify(ct.Name),
+				Title:     name,
+				Heading:   sectionHeadingText(name, opts),
 				Count:     len(typeTasks),
 				Completed: countCompleted(typeTasks),
 			}
@@ -435,20 +490,67 @@ func buildTOCEntries(tl *tasks.TaskList, opts Options) []tocEntry {
 }
 
 // sortTasks returns a sorted copy of tasks for consistent ordering.
-// Completed tasks are placed at the bottom within their group.
+// Completed tasks are placed at the bottom within their group. Tasks with
+// the same status and title keep their relative order from the input.
+// After hints are then applied on top of the sort keys.
 func sortTasks(taskList []tasks.Task, _ Options) []tasks.Task {
 	sorted := make([]tasks.Task, len(taskList))
 	copy(sorted, taskList)
-	sort.Slice(sorted, func(i, j int) bool {
+	sort.SliceStable(sorted, func(i, j int) bool {
 		// Within same phase, sort by status (completed at bottom)
-		iOrder := statusSortOrder(sorted[i].Status)
-		jOrder := statusSortOrder(sorted[j].Status)
+		iOrder := sorted[i].Status.Order()
+		jOrder := sorted[j].Status.Order()
 		if iOrder != jOrder {
 			return iOrder < jOrder
 		}
 		return sorted[i].Title < sorted[j].Title
 	})
-	return sorted
+	return applyAfter(sorted)
+}
+
+// applyAfter reorders sorted tasks so that each task appears after the tasks
+// listed in its After field, where those tasks are in the same list. Among
+// the tasks whose predecessors have been placed, the earliest in sorted order
+// goes next, so the existing order is kept wherever the hints allow. Hints
+// that form a cycle are ignored for the tasks involved.
+func applyAfter(sorted []tasks.Task) []tasks.Task {
+	present := make(map[string]bool, len(sorted))
+	hasHints := false
+	for _, task := range sorted {
+		present[task.ID] = true
+		if len(task.After) > 0 {
+			hasHints = true
+		}
+	}
+	if !hasHints {
+		return sorted
+	}
+
+	placed := make(map[string]bool, len(sorted))
+	ready := func(task tasks.Task) bool {
+		for _, id := range task.After {
+			if present[id] && !placed[id] && id != task.ID {
+				return false
+			}
+		}
+		return true
+	}
+
+	result := make([]tasks.Task, 0, len(sorted))
+	remaining := sorted
+	for len(remaining) > 0 {
+		next := 0
+		for i, task := range remaining {
+			if ready(task) {
+				next = i
+				break
+			}
+		}
+		placed[remaining[next].ID] = true
+		result = append(result, remaining[next])
+		remaining = append(remaining[:next:next], remaining[next+1:]...)
+	}
+	return result
 }
 
 func renderByArea(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
@@ -478,16 +580,10 @@ func renderByArea(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
 func renderByType(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
 	tasksByType := tl.TasksByType()
 
-	registry := changelog.DefaultRegistry
-	allTypes := registry.All()
+	for _, name := range typeSectionNames(tasksByType) {
+		typeTasks := tasksByType[name]
 
-	for _, ct := range allTypes {
-		typeTasks := tasksByType[ct.Name]
-		if len(typeTasks) == 0 {
-			continue
-		}
-
-		renderSectionHeading(sb, ct.Name, tl.Project, opts)
+		renderSectionHeading(sb, name, tl.Project, opts)
 		renderTasks(sb, typeTasks, tl, opts)
 
 		if opts.HorizontalRules {
@@ -611,6 +707,11 @@ func renderTasksAsList(sb *strings.Builder, taskList []tasks.Task, _ *tasks.Task
 
 		sb.WriteString(line + "\n")
 
+		// Render links
+		for _, link := range task.Links {
+			fmt.Fprintf(sb, "  - %s\n", formatLink(link))
+		}
+
 		// Render subtasks
 		for _, subtask := range task.Subtasks {
 			subtaskCheckbox := "[ ]"
@@ -637,7 +738,7 @@ func renderByStatus(sb *strings.Builder, tl *tasks.TaskList, opts Options) {
 
 		legend := tl.GetLegend()
 		header := legend[status].Description
-		if opts.UseEmoji {
+		if opts.UseEmoji && !opts.StatusLabels {
 			header = legend[status].Emoji + " " + header
 		}
 		renderSectionHeading(sb, header, tl.Project, opts)
@@ -683,9 +784,13 @@ func renderTask(sb *strings.Builder, task tasks.Task, num int, tl *tasks.TaskLis
 		}
 	}
 
-	// Add emoji suffix if not using checkboxes
-	if opts.UseEmoji && !opts.UseCheckboxes {
-		title += " " + tl.GetStatusEmoji(task.Status)
+	// Add status suffix if not using checkboxes
+	if !opts.UseCheckboxes {
+		if opts.StatusLabels {
+			title += " (" + tl.GetStatusLabel(task.Status) + ")"
+		} else if opts.UseEmoji {
+			title += " " + tl.GetStatusEmoji(task.Status)
+		}
 	}
 
 	// Add stable anchor for navigation
@@ -697,6 +802,15 @@ func renderTask(sb *strings.Builder, task tasks.Task, num int, tl *tasks.TaskLis
 		sb.WriteString(task.Description + "\n\n")
 	}
 
+	// Links
+	if len(task.Links) > 0 {
+		links := make([]string, 0, len(task.Links))
+		for _, link := range task.Links {
+			links = append(links, formatLink(link))
+		}
+		sb.WriteString("**Links:** " + strings.Join(links, " · ") + "\n\n")
+	}
+
 	// Subtasks
 	if len(task.Subtasks) > 0 {
 		for _, subtask := range task.Subtasks {
@@ -717,3 +831,27 @@ func renderTask(sb *strings.Builder, task tasks.Task, num int, tl *tasks.TaskLis
 		sb.WriteString("\n")
 	}
 }
+
+// formatLink renders a task link as Markdown, with its rel in parentheses.
+// The URL is percent-encoded so that spaces cannot end the link destination.
+func formatLink(link tasks.Link) string {
+	dest := link.URL
+	if u, err := url.Parse(dest); err == nil {
+		dest = u.String()
+	}
+	s := fmt.Sprintf("[%s](%s)", linkLabel(link.Label), dest)
+	if link.Rel != "" {
+		s += " (" + link.Rel + ")"
+	}
+	return s
+}
+
+// linkLabelEscaper escapes characters that would end a Markdown link label
+// early or split a table cell.
+var linkLabelEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `|`, `\|`)
+
+// linkLabel escapes s for use as a Markdown link label and flattens newlines,
+// so the link stays intact in lists and table cells.
+func linkLabel(s string) string {
+	return strings.Join(strings.Fields(linkLabelEscaper.Replace(s)), " ")
+}
@@ -25,6 +25,10 @@ type Options struct {
 	// UseEmoji includes emoji status indicators.
 	UseEmoji bool
 
+	// StatusLabels replaces status emoji with text labels from the legend
+	// descriptions, for plain-text and screen-reader friendly output.
+	StatusLabels bool
+
 	// ShowLegend renders a legend table at the top.
 	ShowLegend bool
 
@@ -55,6 +59,10 @@ type Options struct {
 
 	// ShowNavLinks adds navigation links (e.g., "Top" links in section headings).
 	ShowNavLinks bool
+
+	// MetadataKeys lists task list metadata keys to show below the project
+	// name, in order. Keys missing from the metadata are skipped.
+	MetadataKeys []string
 }
 
 // DefaultIntroText is the standard introductory paragraph.
@@ -100,12 +108,24 @@ func (o Options) WithEmoji(enabled bool) Options {
 	return o
 }
 
+// WithStatusLabels enables or disables text status labels in place of emoji.
+func (o Options) WithStatusLabels(enabled bool) Options {
+	o.StatusLabels = enabled
+	return o
+}
+
 // WithLegend enables or disables the legend section.
 func (o Options) WithLegend(enabled bool) Options {
 	o.ShowLegend = enabled
 	return o
 }
 
+// WithMetadataKeys sets the metadata keys shown below the project name.
+func (o Options) WithMetadataKeys(keys ...string) Options {
+	o.MetadataKeys = keys
+	return o
+}
+
 // WithNumberedItems enables or disables numbered items.
 func (o Options) WithNumberedItems(enabled bool) Options {
 	o.NumberItems = enabled
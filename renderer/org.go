@@ -0,0 +1,115 @@
+package renderer
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// RenderOrg generates Emacs Org-mode content from a TaskList. Each section,
+// as grouped by opts.GroupBy, becomes a top-level headline and each task a
+// second-level TODO or DONE headline with a properties drawer. Subtasks are
+// rendered as checkbox lists and links as Org links. Descriptions are
+// indented under their headline so they cannot start new headlines.
+// Markdown-specific options are ignored.
+func RenderOrg(tl *tasks.TaskList, opts Options) string {
+	var sb strings.Builder
+
+	title := tl.Project
+	if title == "" {
+		title = "Task List"
+	}
+	fmt.Fprintf(&sb, "#+TITLE: %s\n", title)
+	sb.WriteString("#+TODO: TODO | DONE\n")
+
+	for _, section := range groupSections(tl, opts) {
+		fmt.Fprintf(&sb, "\n* %s\n", section.Title)
+		for _, task := range section.Tasks {
+			renderOrgTask(&sb, tl, task)
+		}
+	}
+
+	return sb.String()
+}
+
+func renderOrgTask(sb *strings.Builder, tl *tasks.TaskList, task tasks.Task) {
+	fmt.Fprintf(sb, "** %s %s\n", OrgKeyword(task.Status), task.Title)
+
+	sb.WriteString(":PROPERTIES:\n")
+	orgProperty(sb, "ID", task.ID)
+	orgProperty(sb, "STATUS", tl.GetStatusLabel(task.Status))
+	orgProperty(sb, "AREA", task.Area)
+	orgProperty(sb, "TYPE", task.Type)
+	if task.Phase > 0 {
+		orgProperty(sb, "PHASE", strconv.Itoa(task.Phase))
+	}
+	orgProperty(sb, "OWNERS", strings.Join(task.Owners, ", "))
+	if len(task.DependsOn) > 0 {
+		orgProperty(sb, "DEPENDS_ON", strings.Join(task.DependsOn, " "))
+	}
+	sb.WriteString(":END:\n")
+
+	if task.Description != "" {
+		writeOrgBody(sb, task.Description)
+	}
+	for _, subtask := range task.Subtasks {
+		checkbox := "[ ]"
+		if subtask.Completed {
+			checkbox = "[X]"
+		}
+		fmt.Fprintf(sb, "- %s %s\n", checkbox, strings.Join(strings.Fields(subtask.Description), " "))
+	}
+	for _, link := range task.Links {
+		fmt.Fprintf(sb, "- [[%s][%s]]\n", orgLinkPathEscaper.Replace(link.URL), orgLinkDescription(link.Label))
+	}
+}
+
+// orgDrawerLineRe matches lines Org would read as a drawer boundary, such as
+// ":PROPERTIES:" or ":END:".
+var orgDrawerLineRe = regexp.MustCompile(`^\s*:[\w-]+:\s*$`)
+
+// writeOrgBody writes text as body content of a second-level headline. Each
+// line is indented so a leading "*" cannot start a headline, and drawer-like
+// lines are escaped with a leading comma.
+func writeOrgBody(sb *strings.Builder, text string) {
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			sb.WriteString("\n")
+			continue
+		}
+		if orgDrawerLineRe.MatchString(line) {
+			line = "," + strings.TrimSpace(line)
+		}
+		sb.WriteString("   " + line + "\n")
+	}
+}
+
+// orgLinkPathEscaper escapes brackets and backslashes in an Org link path.
+var orgLinkPathEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+
+// orgLinkDescription flattens s to one line and follows each "]" with a
+// zero-width space, so the description cannot contain the "]]" that ends an
+// Org link.
+func orgLinkDescription(s string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(s), " "), "]", "]\u200b")
+}
+
+// orgProperty writes a property drawer entry, skipping empty values.
+func orgProperty(sb *strings.Builder, name, value string) {
+	if value != "" {
+		fmt.Fprintf(sb, ":%s: %s\n", name, value)
+	}
+}
+
+// OrgKeyword returns the Org-mode TODO keyword for a status: DONE for
+// completed tasks and TODO for all others.
+func OrgKeyword(status tasks.Status) string {
+	if status == tasks.StatusCompleted {
+		return "DONE"
+	}
+	return "TODO"
+}
@@ -0,0 +1,97 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderOrg(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Widgets",
+		Areas:     []tasks.Area{{ID: "core", Name: "Core"}},
+		Tasks: []tasks.Task{
+			{ID: "t1", Title: "Parser", Status: tasks.StatusInProgress, Area: "core", Phase: 2,
+				Description: "Rewrite the parser.",
+				Owners:      []string{"alice", "bob"},
+				Subtasks:    []tasks.Subtask{{Description: "Lexer", Completed: true}, {Description: "AST"}},
+				Links:       []tasks.Link{{Label: "Design", URL: "https://example.com/design"}}},
+			{ID: "t2", Title: "Docs", Status: tasks.StatusCompleted, Area: "core"},
+		},
+	}
+
+	out := RenderOrg(tl, DefaultOptions())
+
+	want := `#+TITLE: Widgets
+#+TODO: TODO | DONE
+
+* Core
+** TODO Parser
+:PROPERTIES:
+:ID: t1
+:STATUS: In Progress
+:AREA: core
+:PHASE: 2
+:OWNERS: alice, bob
+:END:
+   Rewrite the parser.
+- [X] Lexer
+- [ ] AST
+- [[https://example.com/design][Design]]
+** DONE Docs
+:PROPERTIES:
+:ID: t2
+:STATUS: Completed
+:AREA: core
+:END:
+`
+	if out != want {
+		t.Errorf("RenderOrg() =\n%s\nwant\n%s", out, want)
+	}
+}
+
+func TestRenderOrgEscapesBody(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Tasks: []tasks.Task{
+			{ID: "t1", Title: "Parser", Status: tasks.StatusPlanned,
+				Description: "Notes:\n* foo\n\n:PROPERTIES:\n:END:",
+				Subtasks:    []tasks.Subtask{{Description: "multi\n* line"}}},
+		},
+	}
+
+	out := RenderOrg(tl, DefaultOptions())
+	want := `:END:
+   Notes:
+   * foo
+
+   ,:PROPERTIES:
+   ,:END:
+- [ ] multi * line
+`
+	if !strings.HasSuffix(out, want) {
+		t.Errorf("RenderOrg() =\n%s\nwant suffix\n%s", out, want)
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "* ") && line != "* Other" {
+			t.Errorf("Description produced a headline: %q", line)
+		}
+	}
+}
+
+func TestOrgKeyword(t *testing.T) {
+	for _, status := range tasks.StatusOrder() {
+		want := "TODO"
+		if status == tasks.StatusCompleted {
+			want = "DONE"
+		}
+		if got := OrgKeyword(status); got != want {
+			t.Errorf("OrgKeyword(%s) = %s, want %s", status, got, want)
+		}
+	}
+	if !strings.Contains(RenderOrg(&tasks.TaskList{}, DefaultOptions()), "#+TITLE: Task List") {
+		t.Error("RenderOrg() should default the title when the project is empty")
+	}
+}
@@ -1,6 +1,7 @@
 package renderer
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -511,3 +512,190 @@ func TestIsTaskCompleteNoSubtasks(t *testing.T) {
 		t.Error("Task without subtasks and not completed status should not be complete")
 	}
 }
+
+func TestSortTasksStableTiebreak(t *testing.T) {
+	var input []tasks.Task
+	for i := 0; i < 20; i++ {
+		input = append(input, tasks.Task{ID: fmt.Sprintf("task-%02d", i), Title: "Same", Status: tasks.StatusPlanned})
+	}
+
+	sorted := sortTasks(input, DefaultOptions())
+	for i, task := range sorted {
+		if task.ID != input[i].ID {
+			t.Fatalf("sortTasks()[%d] = %s, want %s (input order)", i, task.ID, input[i].ID)
+		}
+	}
+}
+
+func TestSortTasksAfter(t *testing.T) {
+	input := []tasks.Task{
+		{ID: "a", Title: "A", Status: tasks.StatusPlanned, After: []string{"c"}},
+		{ID: "b", Title: "B", Status: tasks.StatusPlanned},
+		{ID: "c", Title: "C", Status: tasks.StatusPlanned},
+		{ID: "d", Title: "D", Status: tasks.StatusPlanned, After: []string{"missing"}},
+		{ID: "e", Title: "E", Status: tasks.StatusCompleted},
+	}
+
+	var got []string
+	for _, task := range sortTasks(input, DefaultOptions()) {
+		got = append(got, task.ID)
+	}
+	want := []string{"b", "c", "a", "d", "e"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("sortTasks() = %v, want %v", got, want)
+	}
+
+	// Cyclic hints fall back to the sort order.
+	cyclic := []tasks.Task{
+		{ID: "x", Title: "X", Status: tasks.StatusPlanned, After: []string{"y"}},
+		{ID: "y", Title: "Y", Status: tasks.StatusPlanned, After: []string{"x"}},
+	}
+	sorted := sortTasks(cyclic, DefaultOptions())
+	if len(sorted) != 2 || sorted[0].ID != "x" || sorted[1].ID != "y" {
+		t.Errorf("sortTasks() with cyclic hints = %v, want [x y]", sorted)
+	}
+}
+
+func TestRenderMetadataKeys(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Metadata:  map[string]string{"team": "Platform", "costCenter": "42", "visibility": "internal"},
+	}
+
+	out := Render(tl, DefaultOptions().WithMetadataKeys("team", "missing", "costCenter"))
+	if !strings.Contains(out, "**Project:** Test\n\n- **team:** Platform\n- **costCenter:** 42\n\n") {
+		t.Errorf("Expected selected metadata after project name\n%s", out)
+	}
+	if strings.Contains(out, "visibility") {
+		t.Error("Unselected metadata keys should not be rendered")
+	}
+
+	if out := Render(tl, DefaultOptions()); strings.Contains(out, "Platform") {
+		t.Error("Metadata should not be rendered by default")
+	}
+}
+
+func TestRenderEscapesLinkLabels(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Pipes | and [brackets]", Status: tasks.StatusPlanned, Links: []tasks.Link{
+				{Label: "Spec [draft] | v2", URL: "https://example.com/spec"},
+			}},
+		},
+	}
+
+	output := Render(tl, DefaultOptions())
+	for _, want := range []string{
+		"[Spec \\[draft\\] \\| v2](https://example.com/spec)",
+		"| - | [Pipes \\| and \\[brackets\\]](#1) |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in output:\n%s", want, output)
+		}
+	}
+
+	tl.Tasks[0].Links[0].URL = "https://example.com/wiki/Foo_(bar) x"
+	output = Render(tl, DefaultOptions())
+	if !strings.Contains(output, "(https://example.com/wiki/Foo_%28bar%29%20x)") {
+		t.Errorf("Expected percent-encoded link URL in output:\n%s", output)
+	}
+	tl.Tasks[0].Links[0].URL = "https://example.com/spec"
+
+	org := RenderOrg(tl, DefaultOptions())
+	if !strings.Contains(org, "- [[https://example.com/spec][Spec [draft]\u200b | v2]]") {
+		t.Errorf("Expected escaped Org link in output:\n%s", org)
+	}
+}
+
+func TestRenderLinks(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Task 1", Status: tasks.StatusPlanned, Links: []tasks.Link{
+				{Label: "Design", URL: "https://example.com/design", Rel: "design"},
+				{Label: "PR #12", URL: "https://example.com/pr/12"},
+			}},
+		},
+	}
+
+	output := Render(tl, DefaultOptions())
+	if !strings.Contains(output, "**Links:** [Design](https://example.com/design) (design) · [PR #12](https://example.com/pr/12)") {
+		t.Errorf("Expected links line in output:\n%s", output)
+	}
+
+	opts := DefaultOptions().WithGroupBy(GroupByPhase)
+	opts.ShowAreaSubheadings = true
+	tl.Areas = []tasks.Area{{ID: "core", Name: "Core"}}
+	output = Render(tl, opts)
+	if !strings.Contains(output, "  - [Design](https://example.com/design) (design)") {
+		t.Errorf("Expected compact link list in output:\n%s", output)
+	}
+}
+
+func TestRenderStatusLabels(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Task 1", Status: tasks.StatusInProgress},
+			{ID: "2", Title: "Task 2", Status: tasks.StatusCompleted},
+		},
+	}
+
+	opts := DefaultOptions().WithStatusLabels(true).WithGroupBy(GroupByStatus).WithCheckboxes(false)
+	output := Render(tl, opts)
+
+	for _, emoji := range []string{"🚧", "✅"} {
+		if strings.Contains(output, emoji) {
+			t.Errorf("Expected no %s emoji in output:\n%s", emoji, output)
+		}
+	}
+	if !strings.Contains(output, "| In Progress |") {
+		t.Error("Expected text status label in overview table")
+	}
+	if !strings.Contains(output, "### Task 1 (In Progress)") {
+		t.Error("Expected text status label after task title")
+	}
+}
+
+func TestRenderByTypeCustomTypes(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Terraform modules", Status: tasks.StatusPlanned, Type: "Infra"},
+			{ID: "2", Title: "Dashboards", Status: tasks.StatusPlanned, Type: "Platform"},
+			{ID: "3", Title: "Login", Status: tasks.StatusPlanned, Type: "Added"},
+			{ID: "4", Title: "Untyped", Status: tasks.StatusPlanned},
+		},
+	}
+	opts := DefaultOptions().WithGroupBy(GroupByType)
+	opts.ShowNavLinks = false
+	opts.ShowTOC = true
+
+	output := Render(tl, opts)
+	added := strings.Index(output, "## Added")
+	infra := strings.Index(output, "## Infra")
+	platform := strings.Index(output, "## Platform")
+	other := strings.Index(output, "## Other")
+	if added < 0 || !(added < infra && infra < platform && platform < other) {
+		t.Errorf("Expected registry types, then custom types sorted, then Other:\n%s", output)
+	}
+	if !strings.Contains(output, "- [Infra (0/1)](#infra)") {
+		t.Errorf("Expected custom type in TOC:\n%s", output)
+	}
+
+	for name, out := range map[string]string{
+		"confluence": RenderConfluence(tl, opts),
+		"org":        RenderOrg(tl, opts),
+		"slides":     RenderSlides(tl, opts),
+	} {
+		if !strings.Contains(out, "Terraform modules") || !strings.Contains(out, "Dashboards") {
+			t.Errorf("%s output should include tasks with custom types:\n%s", name, out)
+		}
+	}
+}
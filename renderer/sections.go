@@ -0,0 +1,96 @@
+package renderer
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// taskSection is a titled group of tasks, used by the non-Markdown renderers.
+type taskSection struct {
+	Title string
+	Tasks []tasks.Task
+}
+
+// groupSections groups tasks into titled sections according to opts.GroupBy,
+// in the same section order as the Markdown renderer. Tasks within a section
+// are sorted with sortTasks, completed tasks are dropped unless
+// opts.ShowCompleted is set, and empty sections are omitted.
+func groupSections(tl *tasks.TaskList, opts Options) []taskSection {
+	var sections []taskSection
+	add := func(title string, taskList []tasks.Task) {
+		var kept []tasks.Task
+		for _, task := range sortTasks(taskList, opts) {
+			if task.Status == tasks.StatusCompleted && !opts.ShowCompleted {
+				continue
+			}
+			kept = append(kept, task)
+		}
+		if len(kept) > 0 {
+			sections = append(sections, taskSection{Title: title, Tasks: kept})
+		}
+	}
+
+	switch opts.GroupBy {
+	case GroupByPhase:
+		byPhase := tl.TasksByPhase()
+		for _, phase := range tl.PhaseNumbers() {
+			add(fmt.Sprintf("Phase %d", phase), byPhase[phase])
+		}
+		add("Unphased", byPhase[0])
+	case GroupByStatus:
+		byStatus := tl.TasksByStatus()
+		for _, status := range tasks.StatusOrder() {
+			add(tl.GetStatusLabel(status), byStatus[status])
+		}
+	case GroupByType:
+		byType := tl.TasksByType()
+		for _, name := range typeSectionNames(byType) {
+			add(name, byType[name])
+		}
+		add("Other", byType["_unspecified"])
+	default:
+		areaNames := make(map[string]string)
+		for _, area := range tl.Areas {
+			areaNames[area.ID] = area.Name
+		}
+		byArea := tl.TasksByArea()
+		for _, areaID := range tl.AreaKeysOrdered() {
+			name := areaNames[areaID]
+			switch {
+			case areaID == "_unspecified":
+				name = "Other"
+			case name == "":
+				name = areaID
+			}
+			add(name, byArea[areaID])
+		}
+	}
+	return sections
+}
+
+// typeSectionNames returns the change types to render as sections, given
+// tasks grouped by TaskList.TasksByType: types from the default
+// structured-changelog registry in registry order, then any other types,
+// such as those from a custom registry passed to ValidateWith, sorted
+// alphabetically. The "_unspecified" key is not included.
+func typeSectionNames(byType map[string][]tasks.Task) []string {
+	var names []string
+	known := make(map[string]bool)
+	for _, ct := range changelog.DefaultRegistry.All() {
+		known[ct.Name] = true
+		if len(byType[ct.Name]) > 0 {
+			names = append(names, ct.Name)
+		}
+	}
+	var custom []string
+	for name := range byType {
+		if !known[name] && name != "_unspecified" {
+			custom = append(custom, name)
+		}
+	}
+	sort.Strings(custom)
+	return append(names, custom...)
+}
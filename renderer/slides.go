@@ -0,0 +1,67 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// RenderSlides generates a Marp-compatible Markdown slide deck from a
+// TaskList. The deck opens with a title slide showing overall progress,
+// followed by one slide per section as grouped by opts.GroupBy, with tasks as
+// bullets and per-section status counts in the speaker notes. Emoji status
+// indicators follow opts.UseEmoji and opts.StatusLabels; other Markdown
+// options are ignored.
+func RenderSlides(tl *tasks.TaskList, opts Options) string {
+	var sb strings.Builder
+
+	sb.WriteString("---\nmarp: true\n---\n\n")
+
+	title := tl.Project
+	if title == "" {
+		title = "Task List"
+	}
+	stats := tl.Stats()
+	fmt.Fprintf(&sb, "# %s\n\n", title)
+	fmt.Fprintf(&sb, "**Progress:** %.0f%% complete (%d of %d tasks)\n",
+		stats.CompletedPercent(), stats.CompletedCount(), stats.Total)
+
+	for _, section := range groupSections(tl, opts) {
+		sb.WriteString("\n---\n\n")
+		fmt.Fprintf(&sb, "## %s\n\n", section.Title)
+		for _, task := range section.Tasks {
+			fmt.Fprintf(&sb, "- %s\n", slideBullet(tl, task, opts))
+		}
+		sb.WriteString("\n<!--\n")
+		renderSlideNotes(&sb, tl, section.Tasks)
+		sb.WriteString("-->\n")
+	}
+
+	return sb.String()
+}
+
+// slideBullet returns the bullet text for a task, prefixed with its status
+// emoji or label when enabled.
+func slideBullet(tl *tasks.TaskList, task tasks.Task, opts Options) string {
+	return prefixStatus(tl, task.Title, task.Status, opts)
+}
+
+// renderSlideNotes writes per-status task counts for a section as speaker
+// notes.
+func renderSlideNotes(sb *strings.Builder, tl *tasks.TaskList, taskList []tasks.Task) {
+	completed := 0
+	byStatus := make(map[tasks.Status]int)
+	for _, task := range taskList {
+		byStatus[task.Status]++
+		if task.Status == tasks.StatusCompleted {
+			completed++
+		}
+	}
+	fmt.Fprintf(sb, "%d of %d tasks completed.\n", completed, len(taskList))
+	for _, status := range tasks.StatusOrder() {
+		if count := byStatus[status]; count > 0 {
+			fmt.Fprintf(sb, "%s: %d\n", tl.GetStatusLabel(status), count)
+		}
+	}
+}
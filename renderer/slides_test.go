@@ -0,0 +1,43 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderSlides(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Widgets",
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Design", Status: tasks.StatusCompleted, Phase: 1},
+			{ID: "2", Title: "Build", Status: tasks.StatusInProgress, Phase: 1},
+			{ID: "3", Title: "Launch", Status: tasks.StatusPlanned, Phase: 2},
+		},
+	}
+
+	out := RenderSlides(tl, DefaultOptions().WithGroupBy(GroupByPhase))
+
+	if !strings.HasPrefix(out, "---\nmarp: true\n---\n\n# Widgets\n\n**Progress:** 33% complete (1 of 3 tasks)\n") {
+		t.Errorf("RenderSlides() title slide unexpected:\n%s", out)
+	}
+	if got := strings.Count(out, "\n---\n\n## "); got != 2 {
+		t.Errorf("RenderSlides() produced %d section slides, want 2\n%s", got, out)
+	}
+	for _, want := range []string{
+		"## Phase 1\n\n- 🚧 Build\n- ✅ Design\n",
+		"<!--\n1 of 2 tasks completed.\nIn Progress: 1\nCompleted: 1\n-->",
+		"## Phase 2\n\n- 📋 Launch\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("RenderSlides() missing %q\n%s", want, out)
+		}
+	}
+
+	labeled := RenderSlides(tl, DefaultOptions().WithStatusLabels(true))
+	if !strings.Contains(labeled, "- [Planned] Launch") {
+		t.Errorf("RenderSlides() with status labels missing text label\n%s", labeled)
+	}
+}
@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// githubSlug converts heading text to an anchor slug using the same algorithm
+// as GitHub: lowercase, strip punctuation and symbols, and replace each space
+// with a dash. Unlike slugify, runs of dashes are not collapsed or trimmed.
+func githubSlug(s string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(s)) {
+		switch {
+		case r == ' ':
+			sb.WriteRune('-')
+		case r == '-' || r == '_':
+			sb.WriteRune(r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.IsMark(r):
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// slugger generates unique GitHub-compatible slugs, appending -1, -2, etc.
+// to repeated headings in the order they are encountered.
+type slugger struct {
+	seen map[string]int
+}
+
+func newSlugger() *slugger {
+	return &slugger{seen: make(map[string]int)}
+}
+
+// Slug returns the unique slug for the next heading with the given text.
+func (s *slugger) Slug(heading string) string {
+	base := githubSlug(heading)
+	slug := base
+	for {
+		if _, ok := s.seen[slug]; !ok {
+			break
+		}
+		s.seen[base]++
+		slug = base + "-" + strconv.Itoa(s.seen[base])
+	}
+	s.seen[slug] = 0
+	return slug
+}
+
+// heading is an ATX heading found in rendered Markdown, with the text GitHub
+// derives its anchor from.
+type heading struct {
+	Level int
+	Text  string
+}
+
+var (
+	atxHeadingRe = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	htmlTagRe    = regexp.MustCompile(`<[^>]*>`)
+)
+
+// parseHeadings returns the ATX headings in markdown in document order,
+// skipping fenced code blocks. HTML tags are stripped from the heading text,
+// as GitHub does before computing the anchor slug.
+func parseHeadings(markdown string) []heading {
+	var headings []heading
+	fence := ""
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if m := atxHeadingRe.FindStringSubmatch(line); m != nil {
+			text := strings.TrimSpace(htmlTagRe.ReplaceAllString(m[2], ""))
+			headings = append(headings, heading{Level: len(m[1]), Text: text})
+		}
+	}
+	return headings
+}
+
+// sectionHeadingText returns the text GitHub sees for a section heading,
+// including the "↑ Top" navigation link when enabled.
+func sectionHeadingText(title string, opts Options) string {
+	if opts.ShowNavLinks {
+		return title + " ↑ Top"
+	}
+	return title
+}
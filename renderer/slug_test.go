@@ -0,0 +1,139 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestGithubSlug(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Simple Text", "simple-text"},
+		{"Special!@#Characters", "specialcharacters"},
+		{"Core Features ↑ Top", "core-features--top"},
+		{"🚧 In Progress", "-in-progress"},
+		{"snake_case and-dash", "snake_case-and-dash"},
+		{"Café Déjà Vu", "café-déjà-vu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := githubSlug(tt.input); got != tt.expected {
+				t.Errorf("githubSlug(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSluggerDuplicates(t *testing.T) {
+	s := newSlugger()
+	want := []string{"core", "core-1", "core-2"}
+	for _, w := range want {
+		if got := s.Slug("Core"); got != w {
+			t.Errorf("Slug(Core) = %q, want %q", got, w)
+		}
+	}
+	// A heading whose natural slug collides with a generated suffix.
+	if got := s.Slug("Core 1"); got != "core-1-1" {
+		t.Errorf("Slug(Core 1) = %q, want %q", got, "core-1-1")
+	}
+}
+
+func TestRenderTOCDuplicateHeadings(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Areas: []tasks.Area{
+			{ID: "a", Name: "Core"},
+			{ID: "b", Name: "Core"},
+			{ID: "c", Name: "Status"},
+		},
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Task 1", Status: tasks.StatusPlanned, Area: "a"},
+			{ID: "2", Title: "Task 2", Status: tasks.StatusPlanned, Area: "b"},
+			{ID: "3", Title: "Task 3", Status: tasks.StatusPlanned, Area: "c"},
+		},
+	}
+
+	opts := DefaultOptions()
+	opts.ShowTOC = true
+	opts.ShowNavLinks = false
+	output := Render(tl, opts)
+
+	for _, want := range []string{"](#core)", "](#core-1)", "](#status-1)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected TOC link %q in output", want)
+		}
+	}
+
+	opts.ShowNavLinks = true
+	output = Render(tl, opts)
+	if !strings.Contains(output, "](#core--top)") {
+		t.Error("Expected TOC link to account for navigation link text")
+	}
+}
+
+func TestParseHeadings(t *testing.T) {
+	md := "# Title\n\n## Core <a href=\"#top\">↑ Top</a>\n\n#notaheading\n\n```\n## in code\n```\n\n### C# ##\n"
+	got := parseHeadings(md)
+	want := []heading{{1, "Title"}, {2, "Core ↑ Top"}, {3, "C#"}}
+	if len(got) != len(want) {
+		t.Fatalf("parseHeadings() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("parseHeadings()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRenderTOCTaskHeadingCollision(t *testing.T) {
+	tl := &tasks.TaskList{
+		IRVersion: "1.0",
+		Project:   "Test",
+		Areas: []tasks.Area{
+			{ID: "a", Name: "Core"},
+			{ID: "b", Name: "Docs"},
+		},
+		Tasks: []tasks.Task{
+			{ID: "1", Title: "Docs", Status: tasks.StatusPlanned, Area: "a"},
+			{ID: "2", Title: "Guide", Status: tasks.StatusPlanned, Area: "b"},
+		},
+	}
+
+	opts := DefaultOptions()
+	opts.ShowTOC = true
+	opts.ShowNavLinks = false
+	opts.UseCheckboxes = false
+	opts.UseEmoji = false
+	output := Render(tl, opts)
+	if !strings.Contains(output, "- [Docs (0/1)](#docs-1)") {
+		t.Errorf("Expected area TOC link to skip the task heading's slug:\n%s", output)
+	}
+
+	// Phase headings collide with a task title and an area subheading.
+	tl.Tasks = []tasks.Task{
+		{ID: "1", Title: "Phase 2", Status: tasks.StatusPlanned, Phase: 1},
+		{ID: "2", Title: "Other", Status: tasks.StatusPlanned, Phase: 2},
+	}
+	opts.GroupBy = GroupByPhase
+	output = Render(tl, opts)
+	if !strings.Contains(output, "- [Phase 2 (0/1)](#phase-2-1)") {
+		t.Errorf("Expected phase TOC link to skip the task heading's slug:\n%s", output)
+	}
+
+	opts.ShowAreaSubheadings = true
+	tl.Tasks = []tasks.Task{
+		{ID: "1", Title: "Task", Status: tasks.StatusPlanned, Phase: 1, Area: "a"},
+		{ID: "2", Title: "Task", Status: tasks.StatusPlanned, Phase: 3, Area: "b"},
+	}
+	tl.Areas[0].Name = "Phase 3"
+	output = Render(tl, opts)
+	if !strings.Contains(output, "- [Phase 3 (0/1)](#phase-3-1)") {
+		t.Errorf("Expected phase TOC link to skip the area subheading's slug:\n%s", output)
+	}
+}
@@ -0,0 +1,27 @@
+package renderer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+// RenderWorkload generates a Markdown table of each owner's open work: the
+// number of in-progress and planned tasks and the estimated days remaining.
+// Owners are ordered as by TaskList.Workloads, with unassigned tasks last.
+func RenderWorkload(tl *tasks.TaskList) string {
+	var sb strings.Builder
+	sb.WriteString("| Owner | In Progress | Planned | Estimated Days |\n")
+	sb.WriteString("|-------|-------------|---------|----------------|\n")
+	for _, w := range tl.Workloads() {
+		owner := w.Owner
+		if owner == "_unassigned" {
+			owner = "Unassigned"
+		}
+		fmt.Fprintf(&sb, "| %s | %d | %d | %s |\n", owner, w.InProgress, w.Planned,
+			strconv.FormatFloat(w.EstimatedDays, 'f', -1, 64))
+	}
+	return sb.String()
+}
@@ -0,0 +1,26 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/grokify/structured-tasks/tasks"
+)
+
+func TestRenderWorkload(t *testing.T) {
+	tl := &tasks.TaskList{
+		Tasks: []tasks.Task{
+			{ID: "1", Status: tasks.StatusPlanned, Owners: []string{"alice"}, EstimatedDays: 1.5},
+			{ID: "2", Status: tasks.StatusInProgress, Owners: []string{"bob"}},
+			{ID: "3", Status: tasks.StatusPlanned},
+		},
+	}
+
+	want := "| Owner | In Progress | Planned | Estimated Days |\n" +
+		"|-------|-------------|---------|----------------|\n" +
+		"| bob | 1 | 0 | 0 |\n" +
+		"| alice | 0 | 1 | 1.5 |\n" +
+		"| Unassigned | 0 | 1 | 0 |\n"
+	if got := RenderWorkload(tl); got != want {
+		t.Errorf("RenderWorkload() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+var (
+	parseOnce sync.Once
+	parsed    map[string]any
+	parseErr  error
+)
+
+// Schema returns SchemaV1 parsed into generic JSON values, for tools that
+// introspect the schema. It is parsed on first use and cached, so callers
+// must not modify the returned map.
+func Schema() (map[string]any, error) {
+	parseOnce.Do(func() {
+		parseErr = json.Unmarshal(SchemaV1, &parsed)
+	})
+	return parsed, parseErr
+}
+
+// RequiredFields returns the names of the required top-level properties of a
+// task list, in schema order.
+func RequiredFields() []string {
+	s, err := Schema()
+	if err != nil {
+		return nil
+	}
+	return stringList(s["required"])
+}
+
+// EnumValues returns the allowed values of the named schema definition, such
+// as "status", or nil if the definition does not exist or is not an enum.
+func EnumValues(definition string) []string {
+	s, err := Schema()
+	if err != nil {
+		return nil
+	}
+	defs, _ := s["definitions"].(map[string]any)
+	def, _ := defs[definition].(map[string]any)
+	return stringList(def["enum"])
+}
+
+// stringList converts a JSON array of strings to a new []string.
+func stringList(v any) []string {
+	values, _ := v.([]any)
+	var result []string
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
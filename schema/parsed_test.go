@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	s, err := Schema()
+	if err != nil {
+		t.Fatalf("Schema() error = %v", err)
+	}
+	if s["title"] != "Structured Tasks IR" {
+		t.Errorf("Schema() title = %v", s["title"])
+	}
+
+	again, _ := Schema()
+	if reflect.ValueOf(s).Pointer() != reflect.ValueOf(again).Pointer() {
+		t.Error("Schema() should return the cached parse")
+	}
+}
+
+func TestRequiredFields(t *testing.T) {
+	if got, want := RequiredFields(), []string{"irVersion", "project"}; !slices.Equal(got, want) {
+		t.Errorf("RequiredFields() = %v, want %v", got, want)
+	}
+}
+
+func TestEnumValues(t *testing.T) {
+	statuses := EnumValues("status")
+	for _, want := range []string{"completed", "inProgress", "blocked", "planned", "future"} {
+		if !slices.Contains(statuses, want) {
+			t.Errorf("EnumValues(status) = %v, missing %s", statuses, want)
+		}
+	}
+	if got := EnumValues("missing"); got != nil {
+		t.Errorf("EnumValues(missing) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,35 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Badge is the shields.io endpoint badge schema.
+// See https://shields.io/badges/endpoint-badge.
+type Badge struct {
+	SchemaVersion int    `json:"schemaVersion"`
+	Label         string `json:"label"`
+	Message       string `json:"message"`
+	Color         string `json:"color"`
+}
+
+// BadgeJSON returns a shields.io endpoint badge showing the completed
+// percentage. The color is red below 40%, yellow below 75%, and green otherwise.
+func (tl *TaskList) BadgeJSON() []byte {
+	pct := tl.Stats().CompletedPercent()
+	color := "green"
+	switch {
+	case pct < 40:
+		color = "red"
+	case pct < 75:
+		color = "yellow"
+	}
+	data, _ := json.Marshal(Badge{
+		SchemaVersion: 1,
+		Label:         "tasks",
+		Message:       fmt.Sprintf("%.0f%%", pct),
+		Color:         color,
+	})
+	return data
+}
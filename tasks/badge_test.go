@@ -0,0 +1,39 @@
+package tasks
+
+import "testing"
+
+func TestBadgeJSON(t *testing.T) {
+	tests := []struct {
+		completed, total int
+		want             string
+	}{
+		{0, 0, `{"schemaVersion":1,"label":"tasks","message":"0%","color":"red"}`},
+		{1, 3, `{"schemaVersion":1,"label":"tasks","message":"33%","color":"red"}`},
+		{1, 2, `{"schemaVersion":1,"label":"tasks","message":"50%","color":"yellow"}`},
+		{3, 4, `{"schemaVersion":1,"label":"tasks","message":"75%","color":"green"}`},
+	}
+
+	for _, tt := range tests {
+		tl := &TaskList{}
+		for i := 0; i < tt.total; i++ {
+			status := StatusPlanned
+			if i < tt.completed {
+				status = StatusCompleted
+			}
+			tl.Tasks = append(tl.Tasks, Task{Status: status})
+		}
+		if got := string(tl.BadgeJSON()); got != tt.want {
+			t.Errorf("BadgeJSON() with %d/%d = %s, want %s", tt.completed, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestCompletedPercent(t *testing.T) {
+	if pct := (Stats{}).CompletedPercent(); pct != 0 {
+		t.Errorf("CompletedPercent() on empty stats = %v, want 0", pct)
+	}
+	stats := Stats{Total: 4, ByStatus: map[Status]int{StatusCompleted: 1}}
+	if pct := stats.CompletedPercent(); pct != 25 {
+		t.Errorf("CompletedPercent() = %v, want 25", pct)
+	}
+}
@@ -0,0 +1,89 @@
+package tasks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Equal reports whether two task lists are structurally equal.
+//
+// All serialized fields are compared: the IR version, project, legend,
+// areas, and tasks including their subtasks. Task, area, and subtask order
+// is significant. Differences that do not survive JSON serialization are
+// ignored: nil and empty slices or maps compare equal, and legend map
+// iteration order does not matter. Because MarshalJSON stamps the current
+// schema version, an empty IRVersion equals the current version. Two nil
+// task lists are equal.
+//
+// A task list with a non-finite EstimatedDays cannot be serialized; if
+// either list cannot be, they are compared with reflect.DeepEqual instead,
+// under which NaN is never equal to itself.
+func Equal(a, b *TaskList) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aj, aErr := json.Marshal(a)
+	bj, bErr := json.Marshal(b)
+	if aErr != nil || bErr != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return bytes.Equal(aj, bj)
+}
+
+// ContentHash returns a hex-encoded SHA-256 hash of the task list's compact
+// JSON encoding. Two task lists have the same hash exactly when Equal reports
+// them equal: map key order (legend, metadata) does not affect the hash, but
+// the order of tasks, areas, subtasks, and other slices does. The hash changes
+// if any serialized field changes, including with new fields in later
+// versions of this package. An error is returned if the task list cannot be
+// serialized, such as when a task has a non-finite EstimatedDays.
+func (tl *TaskList) ContentHash() (string, error) {
+	data, err := json.Marshal(tl)
+	if err != nil {
+		return "", fmt.Errorf("content hash: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// StatusTransitionHistogram counts how tasks changed status between two
+// versions of a task list, matching tasks by ID. Keys have the form
+// "planned->inProgress" using status values; tasks whose status did not
+// change are not counted. Tasks only in newer are counted under "added" and
+// tasks only in older under "removed". Tasks without an ID are ignored, and
+// a nil task list is treated as empty.
+func StatusTransitionHistogram(older, newer *TaskList) map[string]int {
+	result := make(map[string]int)
+	before := make(map[string]Status)
+	if older != nil {
+		for _, task := range older.Tasks {
+			if task.ID != "" {
+				before[task.ID] = task.Status
+			}
+		}
+	}
+	if newer != nil {
+		for _, task := range newer.Tasks {
+			if task.ID == "" {
+				continue
+			}
+			from, ok := before[task.ID]
+			switch {
+			case !ok:
+				result["added"]++
+			case from != task.Status:
+				result[string(from)+"->"+string(task.Status)]++
+			}
+			delete(before, task.ID)
+		}
+	}
+	result["removed"] += len(before)
+	if result["removed"] == 0 {
+		delete(result, "removed")
+	}
+	return result
+}
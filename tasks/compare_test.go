@@ -0,0 +1,156 @@
+package tasks
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	base := func() *TaskList {
+		return &TaskList{
+			IRVersion: "1.0",
+			Project:   "test",
+			Legend: map[Status]LegendEntry{
+				StatusCompleted: {Emoji: "✔", Description: "Done"},
+				StatusPlanned:   {Emoji: "…", Description: "Later"},
+			},
+			Tasks: []Task{
+				{ID: "task-1", Title: "Feature 1", Status: StatusCompleted},
+				{ID: "task-2", Title: "Feature 2", Status: StatusPlanned, DependsOn: []string{"task-1"}},
+			},
+		}
+	}
+
+	t.Run("identical", func(t *testing.T) {
+		if !Equal(base(), base()) {
+			t.Error("Expected identical task lists to be equal")
+		}
+	})
+
+	t.Run("nil vs empty slices", func(t *testing.T) {
+		a, b := base(), base()
+		a.Areas = nil
+		b.Areas = []Area{}
+		b.Tasks[0].DependsOn = []string{}
+		if !Equal(a, b) {
+			t.Error("Expected nil and empty slices to compare equal")
+		}
+	})
+
+	t.Run("task order matters", func(t *testing.T) {
+		a, b := base(), base()
+		b.Tasks[0], b.Tasks[1] = b.Tasks[1], b.Tasks[0]
+		if Equal(a, b) {
+			t.Error("Expected reordered tasks to be unequal")
+		}
+	})
+
+	t.Run("field difference", func(t *testing.T) {
+		a, b := base(), base()
+		b.Tasks[1].Status = StatusInProgress
+		if Equal(a, b) {
+			t.Error("Expected differing status to be unequal")
+		}
+	})
+
+	t.Run("nil task lists", func(t *testing.T) {
+		if !Equal(nil, nil) {
+			t.Error("Expected two nil task lists to be equal")
+		}
+		if Equal(base(), nil) {
+			t.Error("Expected nil and non-nil task lists to be unequal")
+		}
+	})
+}
+
+func TestStatusTransitionHistogram(t *testing.T) {
+	older := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusPlanned},
+			{ID: "2", Status: StatusPlanned},
+			{ID: "3", Status: StatusInProgress},
+			{ID: "4", Status: StatusPlanned},
+			{ID: "5", Status: StatusFuture},
+		},
+	}
+	newer := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusInProgress},
+			{ID: "2", Status: StatusInProgress},
+			{ID: "3", Status: StatusCompleted},
+			{ID: "4", Status: StatusPlanned},
+			{ID: "6", Status: StatusPlanned},
+			{Status: StatusPlanned},
+		},
+	}
+
+	want := map[string]int{
+		"planned->inProgress":   2,
+		"inProgress->completed": 1,
+		"added":                 1,
+		"removed":               1,
+	}
+	if got := StatusTransitionHistogram(older, newer); !reflect.DeepEqual(got, want) {
+		t.Errorf("StatusTransitionHistogram() = %v, want %v", got, want)
+	}
+
+	if got := StatusTransitionHistogram(nil, older); got["added"] != 5 || len(got) != 1 {
+		t.Errorf("StatusTransitionHistogram(nil, older) = %v, want 5 added", got)
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	a := &TaskList{
+		Project:  "p",
+		Legend:   map[Status]LegendEntry{StatusPlanned: {Emoji: "📋"}, StatusCompleted: {Emoji: "✅"}},
+		Metadata: map[string]string{"team": "x", "cost": "y"},
+		Tasks:    []Task{{ID: "1"}, {ID: "2"}},
+	}
+	b := &TaskList{
+		Project:  "p",
+		Legend:   map[Status]LegendEntry{StatusCompleted: {Emoji: "✅"}, StatusPlanned: {Emoji: "📋"}},
+		Metadata: map[string]string{"cost": "y", "team": "x"},
+		Tasks:    []Task{{ID: "1"}, {ID: "2"}},
+	}
+
+	hash := mustContentHash(t, a)
+	if len(hash) != 64 {
+		t.Errorf("ContentHash() = %q, want 64 hex characters", hash)
+	}
+	if hash != mustContentHash(t, b) {
+		t.Error("ContentHash() should not depend on map order")
+	}
+
+	b.Tasks = []Task{{ID: "2"}, {ID: "1"}}
+	if hash == mustContentHash(t, b) {
+		t.Error("ContentHash() should depend on task order")
+	}
+
+	b.Tasks[0].EstimatedDays = math.NaN()
+	if got, err := b.ContentHash(); err == nil || got != "" {
+		t.Errorf("ContentHash() with NaN = %q, %v; want error", got, err)
+	}
+}
+
+func mustContentHash(t *testing.T, tl *TaskList) string {
+	t.Helper()
+	hash, err := tl.ContentHash()
+	if err != nil {
+		t.Fatalf("ContentHash() error = %v", err)
+	}
+	return hash
+}
+
+func TestEqualUnserializable(t *testing.T) {
+	a := &TaskList{Tasks: []Task{{ID: "1", EstimatedDays: math.Inf(1)}}}
+	b := &TaskList{Tasks: []Task{{ID: "1", EstimatedDays: math.Inf(1)}}}
+	if !Equal(a, b) {
+		t.Error("Equal() should compare unserializable lists structurally")
+	}
+	a.Tasks[0].EstimatedDays = math.NaN()
+	b.Tasks[0].EstimatedDays = math.NaN()
+	if Equal(a, b) {
+		t.Error("Equal() should never treat NaN estimates as equal")
+	}
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import "time"
+
+// dateLayouts are the accepted ISO 8601 forms for task dates.
+var dateLayouts = []string{time.DateOnly, time.RFC3339}
+
+// parseDate parses s as an ISO 8601 date (YYYY-MM-DD) or RFC 3339 date-time.
+// Dates without a time are interpreted as midnight UTC.
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// InProgressDuration returns how long an in-progress task has been worked on,
+// measured from its start to now. The start is StartedDate or, if that is
+// empty, the earliest valid inProgress event in History. It returns false if
+// the task is not in progress or has no valid start. A start after now
+// yields zero.
+func (t Task) InProgressDuration(now time.Time) (time.Duration, bool) {
+	if t.Status != StatusInProgress {
+		return 0, false
+	}
+	started, ok := t.startTime()
+	if !ok {
+		return 0, false
+	}
+	return max(now.Sub(started), 0), true
+}
+
+// CompletedTime returns when a completed task was completed: the latest
+// valid completed event in History. It returns false if the task is not
+// completed or its history has no such event.
+func (t Task) CompletedTime() (time.Time, bool) {
+	if t.Status != StatusCompleted {
+		return time.Time{}, false
+	}
+	var latest time.Time
+	found := false
+	for _, event := range t.History {
+		if event.Status != StatusCompleted {
+			continue
+		}
+		if d, ok := parseDate(event.Date); ok && (!found || d.After(latest)) {
+			latest, found = d, true
+		}
+	}
+	return latest, found
+}
+
+// startTime returns when work on the task began, from StartedDate or the
+// earliest inProgress event in History.
+func (t Task) startTime() (time.Time, bool) {
+	if t.StartedDate != "" {
+		return parseDate(t.StartedDate)
+	}
+	var earliest time.Time
+	found := false
+	for _, event := range t.History {
+		if event.Status != StatusInProgress {
+			continue
+		}
+		if d, ok := parseDate(event.Date); ok && (!found || d.Before(earliest)) {
+			earliest, found = d, true
+		}
+	}
+	return earliest, found
+}
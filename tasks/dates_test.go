@@ -0,0 +1,107 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInProgressDuration(t *testing.T) {
+	now := time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		task   Task
+		want   time.Duration
+		wantOK bool
+	}{
+		{"date only", Task{Status: StatusInProgress, StartedDate: "2024-03-01"}, 10*24*time.Hour + 12*time.Hour, true},
+		{"date-time", Task{Status: StatusInProgress, StartedDate: "2024-03-11T10:00:00Z"}, 2 * time.Hour, true},
+		{"future start", Task{Status: StatusInProgress, StartedDate: "2024-04-01"}, 0, true},
+		{"not in progress", Task{Status: StatusCompleted, StartedDate: "2024-03-01"}, 0, false},
+		{"no start date", Task{Status: StatusInProgress}, 0, false},
+		{"invalid date", Task{Status: StatusInProgress, StartedDate: "March 1"}, 0, false},
+		{"from history", Task{Status: StatusInProgress, History: []TaskEvent{
+			{Date: "2024-01-01", Status: StatusPlanned},
+			{Date: "2024-03-10", Status: StatusInProgress},
+			{Date: "2024-03-09T12:00:00Z", Status: StatusInProgress},
+		}}, 2 * 24 * time.Hour, true},
+		{"started date wins over history", Task{Status: StatusInProgress, StartedDate: "2024-03-11",
+			History: []TaskEvent{{Date: "2024-03-01", Status: StatusInProgress}}}, 12 * time.Hour, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.task.InProgressDuration(now)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("InProgressDuration() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestValidateStartedDate(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Good", Status: StatusInProgress, StartedDate: "2024-03-01"},
+			{ID: "task-2", Title: "Bad", Status: StatusInProgress, StartedDate: "03/01/2024"},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Pointer != "/tasks/1/startedDate" {
+		t.Errorf("Expected error at /tasks/1/startedDate, got %v", result.Errors)
+	}
+}
+
+func TestValidateHistory(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusInProgress, History: []TaskEvent{
+				{Date: "2024-03-01", Status: StatusPlanned, Note: "scoped"},
+				{Date: "yesterday", Status: StatusInProgress},
+				{Date: "2024-03-05", Status: "shipped"},
+				{},
+			}},
+		},
+	}
+
+	result := Validate(tl)
+	var fields []string
+	for _, e := range result.Errors {
+		fields = append(fields, e.Field)
+	}
+	want := []string{
+		"tasks[0].history[1].date",
+		"tasks[0].history[2].status",
+		"tasks[0].history[3].date",
+		"tasks[0].history[3].status",
+	}
+	if !equalIDs(fields, want) {
+		t.Errorf("Error fields = %v, want %v", fields, want)
+	}
+}
+
+func TestCompletedTime(t *testing.T) {
+	task := Task{Status: StatusCompleted, History: []TaskEvent{
+		{Date: "2024-03-01", Status: StatusCompleted},
+		{Date: "2024-03-02", Status: StatusInProgress},
+		{Date: "2024-03-05", Status: StatusCompleted},
+		{Date: "bad", Status: StatusCompleted},
+	}}
+	got, ok := task.CompletedTime()
+	if !ok || !got.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("CompletedTime() = %v, %v; want 2024-03-05", got, ok)
+	}
+
+	task.Status = StatusInProgress
+	if _, ok := task.CompletedTime(); ok {
+		t.Error("CompletedTime() should be false for tasks that are not completed")
+	}
+	if _, ok := (Task{Status: StatusCompleted}).CompletedTime(); ok {
+		t.Error("CompletedTime() should be false without a completed event")
+	}
+}
@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ValidateDir parses and validates every *.json file in dir, not including
+// subdirectories, and returns the results keyed by file path. A file that
+// cannot be read or parsed does not stop the run: its result is invalid with
+// a single error describing the problem, with an empty Field since it applies
+// to the whole document. An error is returned only if dir itself cannot be
+// read.
+func ValidateDir(dir string) (map[string]ValidationResult, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+	}
+
+	results := make(map[string]ValidationResult)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), ".json") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		tl, err := ParseFile(path)
+		if err != nil {
+			result := ValidationResult{}
+			result.addError("", err.Error())
+			results[path] = result
+			continue
+		}
+		results[path] = Validate(tl)
+	}
+	return results, nil
+}
@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"good.json":    `{"irVersion": "1.0", "project": "p"}`,
+		"invalid.JSON": `{"irVersion": "1.0"}`,
+		"broken.json":  `{`,
+		"notes.txt":    `not a task list`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(dir, "nested.json"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := ValidateDir(dir)
+	if err != nil {
+		t.Fatalf("ValidateDir() error = %v", err)
+	}
+	if len(results) != 3 {
+		t.Errorf("ValidateDir() returned %d results, want 3: %v", len(results), results)
+	}
+	if !results[filepath.Join(dir, "good.json")].Valid {
+		t.Error("good.json should be valid")
+	}
+	if r := results[filepath.Join(dir, "invalid.JSON")]; r.Valid || r.Errors[0].Field != "project" {
+		t.Errorf("invalid.JSON result = %+v, want missing project error", r)
+	}
+	broken := filepath.Join(dir, "broken.json")
+	if r := results[broken]; r.Valid || !strings.Contains(r.Errors[0].Message, ErrParseJSON.Error()) {
+		t.Errorf("broken.json result = %+v, want parse error", r)
+	} else if r.Errors[0].Field != "" || r.Errors[0].Pointer != "" {
+		t.Errorf("broken.json error location = %q, %q; want the whole document", r.Errors[0].Field, r.Errors[0].Pointer)
+	}
+
+	if _, err := ValidateDir(filepath.Join(dir, "missing")); !errors.Is(err, ErrReadFile) {
+		t.Errorf("ValidateDir() on missing dir error = %v, want ErrReadFile", err)
+	}
+}
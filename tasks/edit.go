@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpsertTask replaces the task with the same ID, or appends it if no task
+// with that ID exists. The task ID is required.
+func (tl *TaskList) UpsertTask(task Task) error {
+	if task.ID == "" {
+		return fmt.Errorf("%w: task id", ErrMissingRequiredField)
+	}
+	for i := range tl.Tasks {
+		if tl.Tasks[i].ID == task.ID {
+			tl.Tasks[i] = task
+			return nil
+		}
+	}
+	tl.Tasks = append(tl.Tasks, task)
+	return nil
+}
+
+// RemoveTask removes the task with the given ID, preserving the order of the
+// remaining tasks. It returns false if no task has that ID.
+func (tl *TaskList) RemoveTask(id string) bool {
+	for i := range tl.Tasks {
+		if tl.Tasks[i].ID == id {
+			tl.Tasks = append(tl.Tasks[:i], tl.Tasks[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// MoveTasksToArea assigns the given tasks to a declared area. The move is
+// all-or-nothing: if the area is not declared or any task ID is unknown, an
+// error wrapping ErrInvalidReference is returned and no task is changed.
+func (tl *TaskList) MoveTasksToArea(taskIDs []string, areaID string) error {
+	found := false
+	for _, area := range tl.Areas {
+		if area.ID == areaID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: unknown area: %s", ErrInvalidReference, areaID)
+	}
+
+	positions := make(map[string]int, len(tl.Tasks))
+	for i, task := range tl.Tasks {
+		positions[task.ID] = i
+	}
+	var unknown []string
+	for _, id := range taskIDs {
+		if _, ok := positions[id]; !ok {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("%w: unknown task: %s", ErrInvalidReference, strings.Join(unknown, ", "))
+	}
+
+	for _, id := range taskIDs {
+		tl.Tasks[positions[id]].Area = areaID
+	}
+	return nil
+}
+
+// SetStatus moves the task with the given ID to a new status, checking the
+// change with ValidateTransition. Unknown IDs return an error wrapping
+// ErrInvalidReference; invalid statuses and disallowed transitions return
+// the ValidateTransition error. On error the task is unchanged. SetStatus
+// does not record a History event, since it has no date for the change.
+func (tl *TaskList) SetStatus(id string, to Status) error {
+	for i := range tl.Tasks {
+		if tl.Tasks[i].ID != id {
+			continue
+		}
+		if err := ValidateTransition(tl.Tasks[i].Status, to); err != nil {
+			return fmt.Errorf("task %s: %w", id, err)
+		}
+		tl.Tasks[i].Status = to
+		return nil
+	}
+	return fmt.Errorf("%w: unknown task: %s", ErrInvalidReference, id)
+}
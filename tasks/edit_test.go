@@ -0,0 +1,118 @@
+package tasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUpsertTask(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature 1", Status: StatusPlanned},
+		},
+	}
+
+	if err := tl.UpsertTask(Task{ID: "task-1", Title: "Feature 1", Status: StatusCompleted}); err != nil {
+		t.Fatalf("UpsertTask() error = %v", err)
+	}
+	if len(tl.Tasks) != 1 || tl.Tasks[0].Status != StatusCompleted {
+		t.Errorf("Expected task-1 to be replaced, got %+v", tl.Tasks)
+	}
+
+	if err := tl.UpsertTask(Task{ID: "task-2", Title: "Feature 2", Status: StatusPlanned}); err != nil {
+		t.Fatalf("UpsertTask() error = %v", err)
+	}
+	if len(tl.Tasks) != 2 || tl.Tasks[1].ID != "task-2" {
+		t.Errorf("Expected task-2 to be appended, got %+v", tl.Tasks)
+	}
+
+	err := tl.UpsertTask(Task{Title: "No ID"})
+	if !errors.Is(err, ErrMissingRequiredField) {
+		t.Errorf("Expected ErrMissingRequiredField, got %v", err)
+	}
+}
+
+func TestRemoveTask(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "task-1"},
+			{ID: "task-2"},
+			{ID: "task-3"},
+		},
+	}
+
+	if !tl.RemoveTask("task-2") {
+		t.Error("RemoveTask(task-2) = false, want true")
+	}
+	if len(tl.Tasks) != 2 || tl.Tasks[0].ID != "task-1" || tl.Tasks[1].ID != "task-3" {
+		t.Errorf("Unexpected tasks after removal: %+v", tl.Tasks)
+	}
+	if tl.RemoveTask("missing") {
+		t.Error("RemoveTask(missing) = true, want false")
+	}
+}
+
+func TestMoveTasksToArea(t *testing.T) {
+	newTaskList := func() *TaskList {
+		return &TaskList{
+			Areas: []Area{{ID: "core", Name: "Core"}, {ID: "api", Name: "API"}},
+			Tasks: []Task{
+				{ID: "task-1", Area: "core"},
+				{ID: "task-2", Area: "core"},
+				{ID: "task-3"},
+			},
+		}
+	}
+
+	tl := newTaskList()
+	if err := tl.MoveTasksToArea([]string{"task-1", "task-3"}, "api"); err != nil {
+		t.Fatalf("MoveTasksToArea() error = %v", err)
+	}
+	if tl.Tasks[0].Area != "api" || tl.Tasks[1].Area != "core" || tl.Tasks[2].Area != "api" {
+		t.Errorf("Unexpected areas after move: %+v", tl.Tasks)
+	}
+
+	tl = newTaskList()
+	err := tl.MoveTasksToArea([]string{"task-1"}, "ui")
+	if !errors.Is(err, ErrInvalidReference) {
+		t.Errorf("Expected ErrInvalidReference for unknown area, got %v", err)
+	}
+
+	err = tl.MoveTasksToArea([]string{"task-1", "missing"}, "api")
+	if !errors.Is(err, ErrInvalidReference) || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("Expected error naming unknown task, got %v", err)
+	}
+	if !Equal(tl, newTaskList()) {
+		t.Error("Expected task list to be unchanged after failed move")
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "task-1", Status: StatusPlanned},
+			{ID: "task-2", Status: StatusCompleted},
+		},
+	}
+
+	if err := tl.SetStatus("task-1", StatusInProgress); err != nil {
+		t.Fatalf("SetStatus() error = %v", err)
+	}
+	if tl.Tasks[0].Status != StatusInProgress {
+		t.Errorf("Status = %s, want inProgress", tl.Tasks[0].Status)
+	}
+
+	if err := tl.SetStatus("task-2", StatusPlanned); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("SetStatus(completed -> planned) error = %v, want ErrInvalidTransition", err)
+	}
+	if tl.Tasks[1].Status != StatusCompleted {
+		t.Errorf("Status after rejected transition = %s, want completed", tl.Tasks[1].Status)
+	}
+	if err := tl.SetStatus("task-1", "done"); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("SetStatus(unknown status) error = %v, want ErrInvalidStatus", err)
+	}
+	if err := tl.SetStatus("missing", StatusCompleted); !errors.Is(err, ErrInvalidReference) {
+		t.Errorf("SetStatus(missing) error = %v, want ErrInvalidReference", err)
+	}
+}
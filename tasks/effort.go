@@ -0,0 +1,33 @@
+package tasks
+
+// TotalEffort returns the sum of EstimatedDays across all tasks.
+// Tasks without an estimate contribute zero.
+func (tl *TaskList) TotalEffort() float64 {
+	var total float64
+	for _, task := range tl.Tasks {
+		total += task.EstimatedDays
+	}
+	return total
+}
+
+// EffortByStatus returns the sum of EstimatedDays grouped by status.
+// Statuses are included only if at least one task has them.
+func (tl *TaskList) EffortByStatus() map[Status]float64 {
+	result := make(map[Status]float64)
+	for _, task := range tl.Tasks {
+		result[task.Status] += task.EstimatedDays
+	}
+	return result
+}
+
+// RemainingEffort returns the sum of EstimatedDays across tasks that are not
+// completed.
+func (tl *TaskList) RemainingEffort() float64 {
+	var total float64
+	for _, task := range tl.Tasks {
+		if !task.Status.IsTerminal() {
+			total += task.EstimatedDays
+		}
+	}
+	return total
+}
@@ -0,0 +1,44 @@
+package tasks
+
+import "testing"
+
+func TestEffort(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted, EstimatedDays: 2},
+			{ID: "2", Status: StatusInProgress, EstimatedDays: 1.5},
+			{ID: "3", Status: StatusPlanned, EstimatedDays: 3},
+			{ID: "4", Status: StatusPlanned},
+		},
+	}
+
+	if got := tl.TotalEffort(); got != 6.5 {
+		t.Errorf("TotalEffort() = %v, want 6.5", got)
+	}
+	if got := tl.RemainingEffort(); got != 4.5 {
+		t.Errorf("RemainingEffort() = %v, want 4.5", got)
+	}
+
+	byStatus := tl.EffortByStatus()
+	if byStatus[StatusCompleted] != 2 || byStatus[StatusInProgress] != 1.5 || byStatus[StatusPlanned] != 3 {
+		t.Errorf("EffortByStatus() = %v", byStatus)
+	}
+	if _, ok := byStatus[StatusFuture]; ok {
+		t.Errorf("EffortByStatus() should omit statuses with no tasks, got %v", byStatus)
+	}
+}
+
+func TestValidateNegativeEffort(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusPlanned, EstimatedDays: -1},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Pointer != "/tasks/0/estimatedDays" {
+		t.Errorf("Expected error at /tasks/0/estimatedDays, got %v", result.Errors)
+	}
+}
@@ -25,6 +25,9 @@ var (
 	// ErrInvalidFormat indicates an invalid format for a field value.
 	ErrInvalidFormat = errors.New("invalid format")
 
+	// ErrInvalidTransition indicates a disallowed status transition.
+	ErrInvalidTransition = errors.New("invalid status transition")
+
 	// ErrInvalidType indicates an invalid change type.
 	ErrInvalidType = errors.New("invalid change type")
 
@@ -36,6 +39,15 @@ var (
 
 	// ErrWriteFile indicates a file write error.
 	ErrWriteFile = errors.New("failed to write file")
+
+	// ErrDependencyCycle indicates tasks that depend on each other in a cycle.
+	ErrDependencyCycle = errors.New("dependency cycle")
+
+	// ErrUnknownDimension indicates an unsupported grouping dimension.
+	ErrUnknownDimension = errors.New("unknown dimension")
+
+	// ErrLimitExceeded indicates input exceeded a configured parse limit.
+	ErrLimitExceeded = errors.New("limit exceeded")
 )
 
 // ParseError wraps a parsing error with context.
@@ -52,6 +64,21 @@ func (e *ParseError) Unwrap() error {
 	return e.Err
 }
 
+// IRVersionError reports a document IR version this package does not support.
+// It wraps ErrInvalidIRVersion.
+type IRVersionError struct {
+	Version   string // Version found in the document
+	Supported string // Version supported by this package
+}
+
+func (e *IRVersionError) Error() string {
+	return fmt.Sprintf("%v: %s (supported: %s)", ErrInvalidIRVersion, e.Version, e.Supported)
+}
+
+func (e *IRVersionError) Unwrap() error {
+	return ErrInvalidIRVersion
+}
+
 // FieldError represents a validation error for a specific field.
 type FieldError struct {
 	Field   string // Field path (e.g., "items[0].id")
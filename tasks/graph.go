@@ -0,0 +1,250 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// taskIndex returns a map of task ID to task.
+func (tl *TaskList) taskIndex() map[string]Task {
+	index := make(map[string]Task, len(tl.Tasks))
+	for _, task := range tl.Tasks {
+		index[task.ID] = task
+	}
+	return index
+}
+
+// ReadyTasks returns planned tasks whose dependencies are all completed.
+// Planned tasks without dependencies are always ready. A dependency on an
+// unknown task ID is treated as unsatisfied.
+func (tl *TaskList) ReadyTasks() []Task {
+	index := tl.taskIndex()
+	var result []Task
+	for _, task := range tl.Tasks {
+		if task.Status != StatusPlanned {
+			continue
+		}
+		ready := true
+		for _, dep := range task.DependsOn {
+			if d, ok := index[dep]; !ok || d.Status != StatusCompleted {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
+// BlockedTasks returns non-completed tasks that have at least one declared,
+// non-completed dependency. Dependencies on unknown task IDs are ignored.
+func (tl *TaskList) BlockedTasks() []Task {
+	index := tl.taskIndex()
+	var result []Task
+	for _, task := range tl.Tasks {
+		if task.Status == StatusCompleted {
+			continue
+		}
+		for _, dep := range task.DependsOn {
+			if d, ok := index[dep]; ok && d.Status != StatusCompleted {
+				result = append(result, task)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// BlockingCount returns, for each task ID, the number of other non-completed
+// tasks it currently blocks. A task blocks another while it is not completed
+// and is listed in the other task's DependsOn. Only declared tasks are
+// counted, and only direct dependencies are considered, so cycles are safe.
+func (tl *TaskList) BlockingCount() map[string]int {
+	index := tl.taskIndex()
+	result := make(map[string]int, len(index))
+	for id := range index {
+		result[id] = 0
+	}
+	for _, task := range tl.Tasks {
+		if task.Status == StatusCompleted {
+			continue
+		}
+		counted := make(map[string]bool)
+		for _, dep := range task.DependsOn {
+			d, ok := index[dep]
+			if !ok || d.Status == StatusCompleted || dep == task.ID || counted[dep] {
+				continue
+			}
+			counted[dep] = true
+			result[dep]++
+		}
+	}
+	return result
+}
+
+// AreaDependencyGraph aggregates task dependencies to the area level: area X
+// depends on area Y if any task in X depends on a task in Y. Tasks without an
+// area are grouped under "_unspecified". Self-edges are excluded, dependency
+// lists are deduplicated and sorted, and only areas with at least one
+// dependency appear as keys. Dependencies on unknown task IDs are ignored.
+func (tl *TaskList) AreaDependencyGraph() map[string][]string {
+	index := tl.taskIndex()
+	edges := make(map[string]map[string]bool)
+	for _, task := range tl.Tasks {
+		from := areaKey(task)
+		for _, dep := range task.DependsOn {
+			d, ok := index[dep]
+			if !ok {
+				continue
+			}
+			to := areaKey(d)
+			if to == from {
+				continue
+			}
+			if edges[from] == nil {
+				edges[from] = make(map[string]bool)
+			}
+			edges[from][to] = true
+		}
+	}
+
+	result := make(map[string][]string, len(edges))
+	for from, targets := range edges {
+		list := make([]string, 0, len(targets))
+		for to := range targets {
+			list = append(list, to)
+		}
+		sort.Strings(list)
+		result[from] = list
+	}
+	return result
+}
+
+// areaKey returns the task's area, or "_unspecified" if it has none.
+func areaKey(task Task) string {
+	if task.Area == "" {
+		return "_unspecified"
+	}
+	return task.Area
+}
+
+// PropagateBlocked marks tasks as blocked when any of their dependencies is
+// blocked, repeating until no more tasks change so blocking flows through
+// dependency chains. Completed tasks are never changed, and dependencies on
+// unknown task IDs are ignored.
+func (tl *TaskList) PropagateBlocked() {
+	positions := make(map[string]int, len(tl.Tasks))
+	for i, task := range tl.Tasks {
+		positions[task.ID] = i
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := range tl.Tasks {
+			task := &tl.Tasks[i]
+			if task.Status == StatusCompleted || task.Status == StatusBlocked {
+				continue
+			}
+			for _, dep := range task.DependsOn {
+				if j, ok := positions[dep]; ok && tl.Tasks[j].Status == StatusBlocked {
+					task.Status = StatusBlocked
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+// PlanStep is one wave of an execution plan: tasks that can proceed in
+// parallel once all earlier waves are done.
+type PlanStep struct {
+	Wave  int
+	Tasks []Task
+}
+
+// ExecutionPlan groups tasks into waves by dependency depth. Completed tasks
+// form wave 0, which is omitted if there are none. Each later wave contains
+// the remaining tasks whose dependencies are all in earlier waves, so tasks in
+// the same wave can proceed in parallel. Tasks keep their original order
+// within a wave, and dependencies on unknown task IDs are ignored. It returns
+// an error wrapping ErrDependencyCycle, naming the tasks involved, if the
+// remaining tasks cannot all be scheduled.
+func (tl *TaskList) ExecutionPlan() ([]PlanStep, error) {
+	index := tl.taskIndex()
+	done := make(map[string]bool)
+	var plan []PlanStep
+	var remaining []Task
+
+	var completed []Task
+	for _, task := range tl.Tasks {
+		if task.Status == StatusCompleted {
+			completed = append(completed, task)
+		} else {
+			remaining = append(remaining, task)
+		}
+	}
+	if len(completed) > 0 {
+		plan = append(plan, PlanStep{Wave: 0, Tasks: completed})
+		for _, task := range completed {
+			done[task.ID] = true
+		}
+	}
+
+	for wave := 1; len(remaining) > 0; wave++ {
+		var ready, blocked []Task
+		for _, task := range remaining {
+			if depsDone(task, index, done) {
+				ready = append(ready, task)
+			} else {
+				blocked = append(blocked, task)
+			}
+		}
+		if len(ready) == 0 {
+			ids := make([]string, len(blocked))
+			for i, task := range blocked {
+				ids[i] = task.ID
+			}
+			return nil, fmt.Errorf("%w: among tasks %s", ErrDependencyCycle, strings.Join(ids, ", "))
+		}
+		for _, task := range ready {
+			done[task.ID] = true
+		}
+		plan = append(plan, PlanStep{Wave: wave, Tasks: ready})
+		remaining = blocked
+	}
+	return plan, nil
+}
+
+// depsDone reports whether all of the task's known dependencies are done.
+func depsDone(task Task, index map[string]Task, done map[string]bool) bool {
+	for _, dep := range task.DependsOn {
+		if _, ok := index[dep]; ok && !done[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// PhaseTaskConsistency reports tasks that depend on a task in a later phase,
+// which contradicts phases being done in order. Each offending dependency is
+// reported on the dependent task's depends_on field. Unphased tasks and
+// unknown dependencies are not checked.
+func (tl *TaskList) PhaseTaskConsistency() []ValidationError {
+	index := tl.taskIndex()
+	var result ValidationResult
+	for i, task := range tl.Tasks {
+		if task.Phase == 0 {
+			continue
+		}
+		for _, dep := range task.DependsOn {
+			if d, ok := index[dep]; ok && d.Phase > task.Phase {
+				result.addError(fmt.Sprintf("tasks[%d].depends_on", i),
+					fmt.Sprintf("task in phase %d depends on %s in later phase %d", task.Phase, dep, d.Phase))
+			}
+		}
+	}
+	return result.Errors
+}
@@ -0,0 +1,207 @@
+package tasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func taskIDs(taskList []Task) []string {
+	ids := make([]string, 0, len(taskList))
+	for _, task := range taskList {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReadyTasks(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "done", Status: StatusCompleted},
+			{ID: "wip", Status: StatusInProgress},
+			{ID: "free", Status: StatusPlanned},
+			{ID: "unblocked", Status: StatusPlanned, DependsOn: []string{"done"}},
+			{ID: "blocked", Status: StatusPlanned, DependsOn: []string{"done", "wip"}},
+			{ID: "unknown-dep", Status: StatusPlanned, DependsOn: []string{"missing"}},
+			{ID: "idea", Status: StatusFuture},
+		},
+	}
+
+	got := taskIDs(tl.ReadyTasks())
+	want := []string{"free", "unblocked"}
+	if !equalIDs(got, want) {
+		t.Errorf("ReadyTasks() = %v, want %v", got, want)
+	}
+}
+
+func TestBlockedTasksAndBlockingCount(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "done", Status: StatusCompleted},
+			{ID: "core", Status: StatusInProgress},
+			{ID: "a", Status: StatusPlanned, DependsOn: []string{"core", "core"}},
+			{ID: "b", Status: StatusPlanned, DependsOn: []string{"done", "core"}},
+			{ID: "c", Status: StatusPlanned, DependsOn: []string{"done", "missing"}},
+			{ID: "shipped", Status: StatusCompleted, DependsOn: []string{"core"}},
+			{ID: "x", Status: StatusPlanned, DependsOn: []string{"y"}},
+			{ID: "y", Status: StatusPlanned, DependsOn: []string{"x"}},
+		},
+	}
+
+	got := taskIDs(tl.BlockedTasks())
+	want := []string{"a", "b", "x", "y"}
+	if !equalIDs(got, want) {
+		t.Errorf("BlockedTasks() = %v, want %v", got, want)
+	}
+
+	counts := tl.BlockingCount()
+	wantCounts := map[string]int{"done": 0, "core": 2, "a": 0, "b": 0, "c": 0, "shipped": 0, "x": 1, "y": 1}
+	if len(counts) != len(wantCounts) {
+		t.Errorf("BlockingCount() has %d entries, want %d", len(counts), len(wantCounts))
+	}
+	for id, want := range wantCounts {
+		if counts[id] != want {
+			t.Errorf("BlockingCount()[%s] = %d, want %d", id, counts[id], want)
+		}
+	}
+}
+
+func TestAreaDependencyGraph(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "core-1", Area: "core"},
+			{ID: "core-2", Area: "core", DependsOn: []string{"core-1"}},
+			{ID: "api-1", Area: "api", DependsOn: []string{"core-1", "core-2"}},
+			{ID: "ui-1", Area: "ui", DependsOn: []string{"api-1", "core-2", "missing"}},
+			{ID: "misc", DependsOn: []string{"ui-1"}},
+		},
+	}
+
+	graph := tl.AreaDependencyGraph()
+	want := map[string][]string{
+		"api":          {"core"},
+		"ui":           {"api", "core"},
+		"_unspecified": {"ui"},
+	}
+	if len(graph) != len(want) {
+		t.Fatalf("AreaDependencyGraph() = %v, want %v", graph, want)
+	}
+	for area, deps := range want {
+		if !equalIDs(graph[area], deps) {
+			t.Errorf("AreaDependencyGraph()[%s] = %v, want %v", area, graph[area], deps)
+		}
+	}
+}
+
+func TestPropagateBlocked(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "c", Status: StatusPlanned, DependsOn: []string{"b"}},
+			{ID: "b", Status: StatusInProgress, DependsOn: []string{"a"}},
+			{ID: "a", Status: StatusBlocked},
+			{ID: "done", Status: StatusCompleted, DependsOn: []string{"a"}},
+			{ID: "free", Status: StatusPlanned, DependsOn: []string{"done"}},
+		},
+	}
+
+	tl.PropagateBlocked()
+
+	want := map[string]Status{
+		"a": StatusBlocked, "b": StatusBlocked, "c": StatusBlocked,
+		"done": StatusCompleted, "free": StatusPlanned,
+	}
+	for _, task := range tl.Tasks {
+		if task.Status != want[task.ID] {
+			t.Errorf("%s status = %s, want %s", task.ID, task.Status, want[task.ID])
+		}
+	}
+}
+
+func TestExecutionPlan(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "a", Status: StatusCompleted},
+			{ID: "b", Status: StatusPlanned, DependsOn: []string{"a"}},
+			{ID: "c", Status: StatusPlanned, DependsOn: []string{"b", "unknown"}},
+			{ID: "d", Status: StatusInProgress},
+			{ID: "e", Status: StatusPlanned, DependsOn: []string{"b", "d"}},
+		},
+	}
+
+	plan, err := tl.ExecutionPlan()
+	if err != nil {
+		t.Fatalf("ExecutionPlan() error = %v", err)
+	}
+	want := [][]string{{"a"}, {"b", "d"}, {"c", "e"}}
+	if len(plan) != len(want) {
+		t.Fatalf("ExecutionPlan() returned %d waves, want %d", len(plan), len(want))
+	}
+	for i, step := range plan {
+		if step.Wave != i || !equalIDs(taskIDs(step.Tasks), want[i]) {
+			t.Errorf("wave %d = %d %v, want %d %v", i, step.Wave, taskIDs(step.Tasks), i, want[i])
+		}
+	}
+}
+
+func TestExecutionPlanNoCompleted(t *testing.T) {
+	tl := &TaskList{Tasks: []Task{{ID: "a", Status: StatusPlanned}}}
+
+	plan, err := tl.ExecutionPlan()
+	if err != nil || len(plan) != 1 || plan[0].Wave != 1 {
+		t.Errorf("ExecutionPlan() = %v, %v; want a single wave 1", plan, err)
+	}
+}
+
+func TestExecutionPlanCycle(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "a", Status: StatusPlanned},
+			{ID: "b", Status: StatusPlanned, DependsOn: []string{"c"}},
+			{ID: "c", Status: StatusPlanned, DependsOn: []string{"b"}},
+		},
+	}
+
+	_, err := tl.ExecutionPlan()
+	if !errors.Is(err, ErrDependencyCycle) {
+		t.Fatalf("ExecutionPlan() error = %v, want ErrDependencyCycle", err)
+	}
+	if !strings.Contains(err.Error(), "b, c") {
+		t.Errorf("ExecutionPlan() error = %q, want it to name b and c", err)
+	}
+}
+
+func TestPhaseTaskConsistency(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "a", Phase: 1, DependsOn: []string{"b", "c", "d", "unknown"}},
+			{ID: "b", Phase: 3},
+			{ID: "c", Phase: 1},
+			{ID: "d"},
+			{ID: "e", DependsOn: []string{"b"}},
+			{ID: "f", Phase: 2, DependsOn: []string{"c"}},
+		},
+	}
+
+	errs := tl.PhaseTaskConsistency()
+	if len(errs) != 1 {
+		t.Fatalf("PhaseTaskConsistency() = %v, want one error", errs)
+	}
+	if errs[0].Field != "tasks[0].depends_on" || errs[0].Pointer != "/tasks/0/dependsOn" {
+		t.Errorf("PhaseTaskConsistency()[0] = %+v, want error on tasks[0].depends_on", errs[0])
+	}
+	if !strings.Contains(errs[0].Message, "b in later phase 3") {
+		t.Errorf("PhaseTaskConsistency()[0].Message = %q", errs[0].Message)
+	}
+}
@@ -0,0 +1,27 @@
+package tasks
+
+import "iter"
+
+// All returns an iterator over the tasks in their original order, for use
+// with range-over-func: for task := range tl.All() { ... }.
+func (tl *TaskList) All() iter.Seq[Task] {
+	return func(yield func(Task) bool) {
+		for _, task := range tl.Tasks {
+			if !yield(task) {
+				return
+			}
+		}
+	}
+}
+
+// AllFiltered returns an iterator over the tasks matching predicate, in their
+// original order.
+func (tl *TaskList) AllFiltered(predicate func(Task) bool) iter.Seq[Task] {
+	return func(yield func(Task) bool) {
+		for _, task := range tl.Tasks {
+			if predicate(task) && !yield(task) {
+				return
+			}
+		}
+	}
+}
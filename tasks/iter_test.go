@@ -0,0 +1,37 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted},
+			{ID: "2", Status: StatusPlanned},
+			{ID: "3", Status: StatusPlanned},
+		},
+	}
+
+	if got := taskIDs(slices.Collect(tl.All())); !equalIDs(got, []string{"1", "2", "3"}) {
+		t.Errorf("All() = %v, want [1 2 3]", got)
+	}
+
+	planned := tl.AllFiltered(func(task Task) bool { return task.Status == StatusPlanned })
+	if got := taskIDs(slices.Collect(planned)); !equalIDs(got, []string{"2", "3"}) {
+		t.Errorf("AllFiltered() = %v, want [2 3]", got)
+	}
+
+	// Breaking out of the loop stops iteration.
+	var seen []string
+	for task := range tl.All() {
+		seen = append(seen, task.ID)
+		if task.ID == "2" {
+			break
+		}
+	}
+	if !equalIDs(seen, []string{"1", "2"}) {
+		t.Errorf("All() with break visited %v, want [1 2]", seen)
+	}
+}
@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Ordered-key companions for the map-returning groupers. Each returns the keys
+// present in the corresponding TasksBy* map in a deterministic order, with the
+// sentinel key for tasks missing the dimension ("_unspecified" or
+// "_unassigned") always sorted last.
+
+// AreaKeysOrdered returns the keys of TasksByArea: declared areas in
+// declaration order, then undeclared area IDs sorted alphabetically, then
+// "_unspecified" if any task has no area.
+func (tl *TaskList) AreaKeysOrdered() []string {
+	byArea := tl.TasksByArea()
+	keys := make([]string, 0, len(byArea))
+	declared := make(map[string]bool, len(tl.Areas))
+	for _, area := range tl.Areas {
+		if _, ok := byArea[area.ID]; ok && !declared[area.ID] {
+			keys = append(keys, area.ID)
+		}
+		declared[area.ID] = true
+	}
+	var undeclared []string
+	for key := range byArea {
+		if !declared[key] && key != "_unspecified" {
+			undeclared = append(undeclared, key)
+		}
+	}
+	sort.Strings(undeclared)
+	keys = append(keys, undeclared...)
+	if _, ok := byArea["_unspecified"]; ok {
+		keys = append(keys, "_unspecified")
+	}
+	return keys
+}
+
+// TypeKeysOrdered returns the keys of TasksByType sorted alphabetically, with
+// "_unspecified" last.
+func (tl *TaskList) TypeKeysOrdered() []string {
+	return sortedKeys(tl.TasksByType(), "_unspecified")
+}
+
+// OwnerKeysOrdered returns the keys of TasksByOwner sorted alphabetically,
+// with "_unassigned" last.
+func (tl *TaskList) OwnerKeysOrdered() []string {
+	return sortedKeys(tl.TasksByOwner(), "_unassigned")
+}
+
+// HorizonKeysOrdered returns the keys of TasksByHorizon: now, next, and
+// later in that order, then any other values sorted alphabetically, then
+// "_unspecified".
+func (tl *TaskList) HorizonKeysOrdered() []string {
+	byHorizon := tl.TasksByHorizon()
+	keys := make([]string, 0, len(byHorizon))
+	known := make(map[string]bool)
+	for _, horizon := range Horizons() {
+		known[horizon] = true
+		if _, ok := byHorizon[horizon]; ok {
+			keys = append(keys, horizon)
+		}
+	}
+	other := make(map[string][]Task)
+	for key, group := range byHorizon {
+		if !known[key] {
+			other[key] = group
+		}
+	}
+	return append(keys, sortedKeys(other, "_unspecified")...)
+}
+
+// StatusKeysOrdered returns the keys of TasksByStatus in StatusOrder, with
+// any statuses not in StatusOrder appended alphabetically.
+func (tl *TaskList) StatusKeysOrdered() []Status {
+	byStatus := tl.TasksByStatus()
+	keys := make([]Status, 0, len(byStatus))
+	for key := range byStatus {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Order() != keys[j].Order() {
+			return keys[i].Order() < keys[j].Order()
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// sortedKeys returns the map's keys sorted alphabetically with the sentinel
+// key, if present, moved to the end.
+func sortedKeys(m map[string][]Task, sentinel string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		if key != sentinel {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := m[sentinel]; ok {
+		keys = append(keys, sentinel)
+	}
+	return keys
+}
+
+// DistinctValues returns the distinct values set on tasks for a dimension:
+// "area", "phase", "type", "status", "owner", or "horizon". It is intended for building
+// filter dropdowns. Sentinel buckets ("_unspecified", "_unassigned", phase 0)
+// are excluded, since they denote a missing value rather than a value.
+// Phases are returned in numeric order, statuses in StatusOrder with unknown
+// statuses appended alphabetically, horizons in HorizonKeysOrdered order,
+// and all other values alphabetically.
+// An unsupported dimension returns an error wrapping ErrUnknownDimension.
+func (tl *TaskList) DistinctValues(dimension string) ([]string, error) {
+	var values []string
+	switch dimension {
+	case "area":
+		values = sortedKeys(tl.TasksByArea(), "_unspecified")
+	case "type":
+		values = sortedKeys(tl.TasksByType(), "_unspecified")
+	case "owner":
+		values = sortedKeys(tl.TasksByOwner(), "_unassigned")
+	case "horizon":
+		values = tl.HorizonKeysOrdered()
+	case "phase":
+		for _, phase := range tl.PhaseNumbers() {
+			values = append(values, strconv.Itoa(phase))
+		}
+	case "status":
+		for _, status := range tl.StatusKeysOrdered() {
+			if status != "" {
+				values = append(values, string(status))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDimension, dimension)
+	}
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "_unspecified" && value != "_unassigned" {
+			result = append(result, value)
+		}
+	}
+	return result, nil
+}
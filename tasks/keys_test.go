@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestKeysOrdered(t *testing.T) {
+	tl := &TaskList{
+		Areas: []Area{{ID: "core"}, {ID: "cli"}, {ID: "docs"}},
+		Tasks: []Task{
+			{ID: "1", Area: "zeta", Type: "Fixed", Status: StatusCompleted, Owners: []string{"bob"}},
+			{ID: "2", Type: "Added", Status: StatusPlanned},
+			{ID: "3", Area: "cli", Status: StatusInProgress, Owners: []string{"alice"}},
+			{ID: "4", Area: "alpha", Status: "custom"},
+			{ID: "5", Area: "core", Status: StatusPlanned},
+		},
+	}
+
+	if got, want := tl.AreaKeysOrdered(), []string{"core", "cli", "alpha", "zeta", "_unspecified"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AreaKeysOrdered() = %v, want %v", got, want)
+	}
+	if got, want := tl.TypeKeysOrdered(), []string{"Added", "Fixed", "_unspecified"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TypeKeysOrdered() = %v, want %v", got, want)
+	}
+	if got, want := tl.OwnerKeysOrdered(), []string{"alice", "bob", "_unassigned"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OwnerKeysOrdered() = %v, want %v", got, want)
+	}
+	if got, want := tl.StatusKeysOrdered(), []Status{StatusInProgress, StatusPlanned, StatusCompleted, "custom"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StatusKeysOrdered() = %v, want %v", got, want)
+	}
+}
+
+func TestDistinctValues(t *testing.T) {
+	tl := &TaskList{
+		Areas: []Area{{ID: "core"}, {ID: "cli"}},
+		Tasks: []Task{
+			{ID: "1", Area: "core", Type: "Fixed", Phase: 10, Status: StatusCompleted, Owners: []string{"bob"}},
+			{ID: "2", Type: "Added", Phase: 2, Status: StatusPlanned},
+			{ID: "3", Area: "cli", Status: "custom", Owners: []string{"alice"}},
+			{ID: "4", Area: "core", Status: StatusInProgress},
+		},
+	}
+
+	tests := []struct {
+		dimension string
+		want      []string
+	}{
+		{"area", []string{"cli", "core"}},
+		{"type", []string{"Added", "Fixed"}},
+		{"owner", []string{"alice", "bob"}},
+		{"phase", []string{"2", "10"}},
+		{"status", []string{"inProgress", "planned", "completed", "custom"}},
+	}
+	for _, tt := range tests {
+		got, err := tl.DistinctValues(tt.dimension)
+		if err != nil {
+			t.Errorf("DistinctValues(%q) error = %v", tt.dimension, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("DistinctValues(%q) = %v, want %v", tt.dimension, got, tt.want)
+		}
+	}
+
+	if got, err := (&TaskList{}).DistinctValues("area"); err != nil || got == nil || len(got) != 0 {
+		t.Errorf("DistinctValues() on empty list = %v, %v; want empty slice", got, err)
+	}
+	if _, err := tl.DistinctValues("priority"); !errors.Is(err, ErrUnknownDimension) {
+		t.Errorf("DistinctValues(\"priority\") error = %v, want ErrUnknownDimension", err)
+	}
+}
+
+func TestHorizonKeysOrdered(t *testing.T) {
+	tl := &TaskList{Tasks: []Task{
+		{ID: "1", Horizon: HorizonLater},
+		{ID: "2"},
+		{ID: "3", Horizon: "someday"},
+		{ID: "4", Horizon: HorizonNow},
+	}}
+	if got, want := tl.HorizonKeysOrdered(), []string{"now", "later", "someday", "_unspecified"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("HorizonKeysOrdered() = %v, want %v", got, want)
+	}
+	if got, _ := tl.DistinctValues("horizon"); !reflect.DeepEqual(got, []string{"now", "later", "someday"}) {
+		t.Errorf("DistinctValues(\"horizon\") = %v", got)
+	}
+}
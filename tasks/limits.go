@@ -0,0 +1,144 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseLimits bounds the size of task lists accepted by ParseLimited.
+// A zero value for any field disables that limit.
+type ParseLimits struct {
+	// MaxBytes is the maximum size of the input in bytes.
+	MaxBytes int
+
+	// MaxTasks is the maximum number of tasks.
+	MaxTasks int
+
+	// MaxSubtasks is the maximum number of subtasks in a single task.
+	MaxSubtasks int
+
+	// MaxDepth is the maximum JSON nesting depth of objects and arrays.
+	MaxDepth int
+}
+
+// DefaultParseLimits returns generous limits suitable for user-uploaded task lists.
+func DefaultParseLimits() ParseLimits {
+	return ParseLimits{
+		MaxBytes:    10 << 20,
+		MaxTasks:    10000,
+		MaxSubtasks: 1000,
+		MaxDepth:    32,
+	}
+}
+
+// ParseLimited parses JSON data into a TaskList, rejecting input that exceeds
+// the given limits. The input size is checked first, then a token scan
+// checks nesting depth and counts tasks and subtasks, so oversized payloads
+// are rejected without being unmarshaled. Errors wrap ErrLimitExceeded and
+// name the exceeded limit.
+func ParseLimited(data []byte, limits ParseLimits) (*TaskList, error) {
+	if limits.MaxBytes > 0 && len(data) > limits.MaxBytes {
+		return nil, fmt.Errorf("%w: input: %d bytes exceeds maximum of %d", ErrLimitExceeded, len(data), limits.MaxBytes)
+	}
+	if limits.MaxDepth > 0 || limits.MaxTasks > 0 || limits.MaxSubtasks > 0 {
+		if err := scanJSONLimits(data, limits); err != nil {
+			return nil, err
+		}
+	}
+	return Parse(data)
+}
+
+// Roles of the JSON containers tracked by scanJSONLimits.
+const (
+	roleOther = iota
+	roleRoot
+	roleTasks
+	roleTask
+	roleSubtasks
+)
+
+// jsonFrame is an open object or array during scanJSONLimits.
+type jsonFrame struct {
+	array     bool
+	role      int
+	task      int    // index of the enclosing task, for task and subtask frames
+	key       string // current key, for objects
+	expectKey bool   // next string token is a key, for objects
+	count     int    // elements seen so far, for arrays
+}
+
+// scanJSONLimits walks data token by token, returning an error as soon as it
+// nests deeper than limits.MaxDepth or holds more tasks or subtasks than
+// allowed. Keys are matched case-insensitively, as json.Unmarshal does.
+func scanJSONLimits(data []byte, limits ParseLimits) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var stack []*jsonFrame
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrParseJSON, err)
+		}
+
+		var parent *jsonFrame
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1]
+		}
+		if parent != nil && !parent.array && parent.expectKey {
+			if key, ok := tok.(string); ok {
+				parent.key = key
+				parent.expectKey = false
+				continue
+			}
+		}
+
+		if tok == json.Delim('}') || tok == json.Delim(']') {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 && !stack[len(stack)-1].array {
+				stack[len(stack)-1].expectKey = true
+			}
+			continue
+		}
+
+		// tok starts a value: count it, then open a frame if it is a container.
+		if parent != nil && parent.array {
+			parent.count++
+			switch {
+			case parent.role == roleTasks && limits.MaxTasks > 0 && parent.count > limits.MaxTasks:
+				return fmt.Errorf("%w: tasks: exceeds maximum of %d", ErrLimitExceeded, limits.MaxTasks)
+			case parent.role == roleSubtasks && limits.MaxSubtasks > 0 && parent.count > limits.MaxSubtasks:
+				return fmt.Errorf("%w: tasks[%d].subtasks: exceeds maximum of %d", ErrLimitExceeded, parent.task, limits.MaxSubtasks)
+			}
+		}
+		delim, isDelim := tok.(json.Delim)
+		if !isDelim {
+			if parent != nil && !parent.array {
+				parent.expectKey = true
+			}
+			continue
+		}
+
+		if limits.MaxDepth > 0 && len(stack)+1 > limits.MaxDepth {
+			return fmt.Errorf("%w: nesting depth exceeds maximum of %d", ErrLimitExceeded, limits.MaxDepth)
+		}
+		frame := &jsonFrame{array: delim == '[', expectKey: delim == '{'}
+		switch {
+		case parent == nil && !frame.array:
+			frame.role = roleRoot
+		case parent == nil:
+		case parent.role == roleRoot && frame.array && strings.EqualFold(parent.key, "tasks"):
+			frame.role = roleTasks
+		case parent.role == roleTasks && !frame.array:
+			frame.role, frame.task = roleTask, parent.count-1
+		case parent.role == roleTask && frame.array && strings.EqualFold(parent.key, "subtasks"):
+			frame.role, frame.task = roleSubtasks, parent.task
+		}
+		stack = append(stack, frame)
+	}
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseLimited(t *testing.T) {
+	data := []byte(`{
+		"irVersion": "1.0",
+		"project": "test",
+		"tasks": [
+			{"id": "1", "title": "Task 1", "status": "planned", "subtasks": [
+				{"description": "a", "completed": false},
+				{"description": "b", "completed": false}
+			]},
+			{"id": "2", "title": "Task 2", "status": "planned"}
+		]
+	}`)
+
+	tests := []struct {
+		name    string
+		limits  ParseLimits
+		wantErr string
+	}{
+		{name: "no limits", limits: ParseLimits{}},
+		{name: "defaults", limits: DefaultParseLimits()},
+		{name: "too many tasks", limits: ParseLimits{MaxTasks: 1}, wantErr: "tasks: exceeds maximum of 1"},
+		{name: "too many subtasks", limits: ParseLimits{MaxSubtasks: 1}, wantErr: "tasks[0].subtasks"},
+		{name: "too deep", limits: ParseLimits{MaxDepth: 3}, wantErr: "nesting depth"},
+		{name: "too large", limits: ParseLimits{MaxBytes: 64}, wantErr: "input:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl, err := ParseLimited(data, tt.limits)
+			if tt.wantErr == "" {
+				if err != nil || tl == nil {
+					t.Fatalf("ParseLimited() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrLimitExceeded) {
+				t.Fatalf("Expected ErrLimitExceeded, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Error %q should contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := ParseLimited([]byte(`{"tasks": [}`), DefaultParseLimits())
+		if !errors.Is(err, ErrParseJSON) {
+			t.Errorf("Expected ErrParseJSON, got %v", err)
+		}
+	})
+
+	t.Run("rejects before decoding", func(t *testing.T) {
+		// The second task is not a valid Task, so reaching json.Unmarshal
+		// would fail with ErrParseJSON instead of ErrLimitExceeded.
+		_, err := ParseLimited([]byte(`{"tasks": [{"id": "1"}, {"id": 2}]}`), ParseLimits{MaxTasks: 1})
+		if !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("Expected ErrLimitExceeded, got %v", err)
+		}
+
+		_, err = ParseLimited([]byte(`{"Tasks": [{"id": "1"}, {"id": "2", "subtasks": [{}, {}, {"description": 3}]}]}`), ParseLimits{MaxSubtasks: 2})
+		if !errors.Is(err, ErrLimitExceeded) || !strings.Contains(err.Error(), "tasks[1].subtasks") {
+			t.Errorf("Expected subtask limit on tasks[1], got %v", err)
+		}
+	})
+
+	t.Run("nested arrays are not counted as tasks", func(t *testing.T) {
+		data := []byte(`{"areas": [{"id": "a"}, {"id": "b"}], "tasks": [{"id": "1", "dependsOn": ["x", "y", "z"], "subtasks": [{"description": "s"}]}]}`)
+		if _, err := ParseLimited(data, ParseLimits{MaxTasks: 1, MaxSubtasks: 1}); err != nil {
+			t.Errorf("ParseLimited() error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/grokify/structured-tasks/schema"
+)
+
+// MigrationFunc upgrades a task list document from one IR version to the
+// next. It receives the raw JSON document and returns the upgraded document,
+// which must declare the target version in irVersion.
+type MigrationFunc func(data []byte) ([]byte, error)
+
+type migration struct {
+	to string
+	fn MigrationFunc
+}
+
+var (
+	migrationsMu sync.RWMutex
+	migrations   = make(map[string]migration)
+)
+
+// RegisterMigration registers fn to upgrade documents from IR version from
+// to IR version to. Migrations are chained by Migrate until the current
+// schema version is reached. RegisterMigration panics if fn is nil or a
+// migration from the same version is already registered.
+func RegisterMigration(from, to string, fn MigrationFunc) {
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+	if fn == nil {
+		panic("tasks: RegisterMigration fn is nil")
+	}
+	if _, dup := migrations[from]; dup {
+		panic("tasks: RegisterMigration called twice for version " + from)
+	}
+	migrations[from] = migration{to: to, fn: fn}
+}
+
+// Migrate parses a task list document, first applying registered migrations
+// to bring older IR versions up to the current schema version. Documents
+// already at the current version, or without an irVersion, are parsed as is.
+// It returns an *IRVersionError if no migration path exists from the
+// document's version, and an error wrapping ErrInvalidIRVersion if a
+// migration does not produce its declared target version.
+func Migrate(data []byte) (*TaskList, error) {
+	current := schema.SchemaVersion()
+	version, err := irVersionOf(data)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for version != "" && version != current {
+		if seen[version] {
+			return nil, fmt.Errorf("%w: migration cycle at version %s", ErrInvalidIRVersion, version)
+		}
+		seen[version] = true
+
+		migrationsMu.RLock()
+		m, ok := migrations[version]
+		migrationsMu.RUnlock()
+		if !ok {
+			return nil, &IRVersionError{Version: version, Supported: current}
+		}
+
+		data, err = m.fn(data)
+		if err != nil {
+			return nil, fmt.Errorf("migrate from %s to %s: %w", version, m.to, err)
+		}
+		got, err := irVersionOf(data)
+		if err != nil {
+			return nil, err
+		}
+		if got != m.to {
+			return nil, fmt.Errorf("%w: migration from %s produced version %s, want %s", ErrInvalidIRVersion, version, got, m.to)
+		}
+		version = got
+	}
+	return Parse(data)
+}
+
+// irVersionOf returns the irVersion declared by a task list document.
+func irVersionOf(data []byte) (string, error) {
+	var doc struct {
+		IRVersion string `json:"irVersion"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrParseJSON, err)
+	}
+	return doc.IRVersion, nil
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// Migrations are registered once per test binary so tests can run with
+// -count greater than one. Versions are test-specific so registrations do not
+// collide with real migrations in the shared registry.
+var registerTestMigrations sync.Once
+
+func registerMigrationsForTest() {
+	registerTestMigrations.Do(func() {
+		RegisterMigration("0.8-test", "0.9-test", func(data []byte) ([]byte, error) {
+			return bytes.Replace(data, []byte(`"0.8-test"`), []byte(`"0.9-test"`), 1), nil
+		})
+		RegisterMigration("0.9-test", "1.0", func(data []byte) ([]byte, error) {
+			data = bytes.Replace(data, []byte(`"0.9-test"`), []byte(`"1.0"`), 1)
+			return bytes.Replace(data, []byte(`"name"`), []byte(`"project"`), 1), nil
+		})
+		RegisterMigration("0.7-test", "0.7-test-next", func(data []byte) ([]byte, error) {
+			return data, nil
+		})
+		RegisterMigration("0.5-test", "1.0", func(data []byte) ([]byte, error) { return data, nil })
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	registerMigrationsForTest()
+
+	tl, err := Migrate([]byte(`{"irVersion": "0.8-test", "name": "legacy"}`))
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	if tl.IRVersion != "1.0" || tl.Project != "legacy" {
+		t.Errorf("Migrate() = %+v, want irVersion 1.0 and project legacy", tl)
+	}
+
+	tl, err = Migrate([]byte(`{"irVersion": "1.0", "project": "current"}`))
+	if err != nil || tl.Project != "current" {
+		t.Errorf("Migrate() on current version = %v, %v", tl, err)
+	}
+
+	_, err = Migrate([]byte(`{"irVersion": "0.1", "project": "old"}`))
+	var versionErr *IRVersionError
+	if !errors.As(err, &versionErr) || versionErr.Version != "0.1" {
+		t.Errorf("Migrate() without a path error = %v, want *IRVersionError for 0.1", err)
+	}
+
+	if _, err := Migrate([]byte(`{"irVersion": "0.7-test"}`)); !errors.Is(err, ErrInvalidIRVersion) {
+		t.Errorf("Migrate() with a bad migration error = %v, want ErrInvalidIRVersion", err)
+	}
+}
+
+func TestRegisterMigrationPanics(t *testing.T) {
+	registerMigrationsForTest()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic on duplicate registration")
+		}
+	}()
+	RegisterMigration("0.5-test", "1.0", func(data []byte) ([]byte, error) { return data, nil })
+}
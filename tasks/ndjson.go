@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// WriteTasksNDJSON writes tasks as newline-delimited JSON, one task per line.
+func WriteTasksNDJSON(w io.Writer, taskList []Task) error {
+	enc := json.NewEncoder(w)
+	for i, task := range taskList {
+		if err := enc.Encode(task); err != nil {
+			return fmt.Errorf("%w: tasks[%d]: %v", ErrWriteFile, i, err)
+		}
+	}
+	return nil
+}
+
+// ReadTasksNDJSON reads newline-delimited JSON written by WriteTasksNDJSON.
+// Each non-blank line must be a complete JSON task object.
+func ReadTasksNDJSON(r io.Reader) ([]Task, error) {
+	var result []Task
+	br := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var task Task
+			if jerr := json.Unmarshal(line, &task); jerr != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrParseJSON, lineNum, jerr)
+			}
+			result = append(result, task)
+		}
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTasksNDJSONRoundTrip(t *testing.T) {
+	taskList := []Task{
+		{ID: "task-1", Title: "Feature 1", Status: StatusCompleted},
+		{ID: "task-2", Title: "Multi\nline", Status: StatusPlanned, DependsOn: []string{"task-1"}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTasksNDJSON(&buf, taskList); err != nil {
+		t.Fatalf("WriteTasksNDJSON() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d:\n%s", len(lines), buf.String())
+	}
+	for i, line := range lines {
+		if !json.Valid([]byte(line)) {
+			t.Errorf("Line %d is not standalone JSON: %s", i+1, line)
+		}
+	}
+
+	got, err := ReadTasksNDJSON(&buf)
+	if err != nil {
+		t.Fatalf("ReadTasksNDJSON() error = %v", err)
+	}
+	if !Equal(&TaskList{Tasks: got}, &TaskList{Tasks: taskList}) {
+		t.Errorf("Round trip mismatch: got %+v", got)
+	}
+}
+
+func TestReadTasksNDJSON(t *testing.T) {
+	input := "{\"id\": \"a\"}\n\n{\"id\": \"b\"}"
+	got, err := ReadTasksNDJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ReadTasksNDJSON() error = %v", err)
+	}
+	if !equalIDs(taskIDs(got), []string{"a", "b"}) {
+		t.Errorf("ReadTasksNDJSON() = %v", taskIDs(got))
+	}
+
+	_, err = ReadTasksNDJSON(strings.NewReader("{\"id\": \"a\"}\n{bad}\n"))
+	if !errors.Is(err, ErrParseJSON) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected ErrParseJSON on line 2, got %v", err)
+	}
+}
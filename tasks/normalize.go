@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"sort"
+	"strings"
+)
+
+// Normalize cleans up whitespace in place: it trims the project name, area
+// and task IDs, and ID references (task areas, DependsOn, Blocks, After, and
+// owners), and it trims and collapses internal whitespace runs in area names
+// and task titles. Descriptions are left unchanged. Validate warns about the
+// fields Normalize would change.
+func (tl *TaskList) Normalize() {
+	tl.Project = collapseSpace(tl.Project)
+	for i := range tl.Areas {
+		area := &tl.Areas[i]
+		area.ID = strings.TrimSpace(area.ID)
+		area.Name = collapseSpace(area.Name)
+	}
+	for i := range tl.Tasks {
+		task := &tl.Tasks[i]
+		task.ID = strings.TrimSpace(task.ID)
+		task.Title = collapseSpace(task.Title)
+		task.Area = strings.TrimSpace(task.Area)
+		trimAll(task.DependsOn)
+		trimAll(task.Blocks)
+		trimAll(task.After)
+		trimAll(task.Owners)
+	}
+}
+
+// collapseSpace trims s and replaces each internal run of whitespace with a
+// single space.
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// trimAll trims whitespace from each string in place.
+func trimAll(values []string) {
+	for i, v := range values {
+		values[i] = strings.TrimSpace(v)
+	}
+}
+
+// Canonicalize sorts each task's subtasks for reproducible output. Subtasks
+// with an ID are sorted by ID (byte-wise) among the positions held by
+// subtasks with IDs; subtasks without an ID keep their exact positions. Tasks
+// themselves are not reordered, since their position is their order.
+// Canonicalize is idempotent.
+func (tl *TaskList) Canonicalize() {
+	for i := range tl.Tasks {
+		canonicalizeSubtasks(tl.Tasks[i].Subtasks)
+	}
+}
+
+func canonicalizeSubtasks(subtasks []Subtask) {
+	var slots []int
+	var withID []Subtask
+	for i, subtask := range subtasks {
+		if subtask.ID != "" {
+			slots = append(slots, i)
+			withID = append(withID, subtask)
+		}
+	}
+	sort.SliceStable(withID, func(a, b int) bool {
+		return withID[a].ID < withID[b].ID
+	})
+	for k, i := range slots {
+		subtasks[i] = withID[k]
+	}
+}
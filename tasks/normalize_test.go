@@ -0,0 +1,103 @@
+package tasks
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "  My   Project ",
+		Areas:     []Area{{ID: "core ", Name: " Core\tFeatures "}},
+		Tasks: []Task{
+			{ID: " task-1", Title: "Add  search ", Status: StatusPlanned, Area: "core ", Description: "  keep  me "},
+			{ID: "task-2", Title: "Docs", Status: StatusPlanned, DependsOn: []string{" task-1"}, Owners: []string{" alice"}},
+		},
+	}
+
+	result := Validate(tl)
+	if !result.Valid {
+		t.Fatalf("Whitespace should only warn, got errors %v", result.Errors)
+	}
+	warned := make(map[string]bool)
+	for _, w := range result.Warnings {
+		warned[w.Field] = true
+	}
+	for _, field := range []string{"project", "areas[0].id", "areas[0].name", "tasks[0].id", "tasks[0].title", "tasks[0].area"} {
+		if !warned[field] {
+			t.Errorf("Expected whitespace warning on %s, got %v", field, result.Warnings)
+		}
+	}
+
+	tl.Normalize()
+
+	if tl.Project != "My Project" {
+		t.Errorf("Project = %q, want %q", tl.Project, "My Project")
+	}
+	if tl.Areas[0].ID != "core" || tl.Areas[0].Name != "Core Features" {
+		t.Errorf("Areas[0] = %+v", tl.Areas[0])
+	}
+	task := tl.Tasks[0]
+	if task.ID != "task-1" || task.Title != "Add search" || task.Area != "core" {
+		t.Errorf("Tasks[0] = %+v", task)
+	}
+	if task.Description != "  keep  me " {
+		t.Errorf("Description = %q, want unchanged", task.Description)
+	}
+	if tl.Tasks[1].DependsOn[0] != "task-1" || tl.Tasks[1].Owners[0] != "alice" {
+		t.Errorf("Tasks[1] = %+v, want trimmed references", tl.Tasks[1])
+	}
+
+	result = Validate(tl)
+	if !result.Valid || len(result.Warnings) != 0 {
+		t.Errorf("Expected clean result after Normalize, got errors %v warnings %v", result.Errors, result.Warnings)
+	}
+}
+
+func TestValidateIDEmbeddedWhitespace(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task 1", Title: "Feature", Status: StatusPlanned},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "tasks[0].id" || result.Warnings[0].Message != "contains whitespace" {
+		t.Errorf("Expected embedded whitespace warning on tasks[0].id, got %v", result.Warnings)
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "b", Subtasks: []Subtask{
+				{ID: "s3", Description: "three"},
+				{Description: "anonymous"},
+				{ID: "s1", Description: "one"},
+				{ID: "s2", Description: "two"},
+			}},
+			{ID: "a"},
+		},
+	}
+
+	tl.Canonicalize()
+
+	var got []string
+	for _, subtask := range tl.Tasks[0].Subtasks {
+		got = append(got, subtask.Description)
+	}
+	want := []string{"one", "anonymous", "two", "three"}
+	if !equalIDs(got, want) {
+		t.Errorf("Canonicalize() subtasks = %v, want %v", got, want)
+	}
+	if tl.Tasks[0].ID != "b" {
+		t.Error("Canonicalize() should not reorder tasks")
+	}
+
+	before, _ := ToJSON(tl)
+	tl.Canonicalize()
+	after, _ := ToJSON(tl)
+	if string(before) != string(after) {
+		t.Error("Canonicalize() should be idempotent")
+	}
+}
@@ -1,9 +1,15 @@
 package tasks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+
+	"github.com/grokify/structured-tasks/schema"
 )
 
 // ParseFile reads and parses a TASKS.json file.
@@ -15,6 +21,60 @@ func ParseFile(path string) (*TaskList, error) {
 	return Parse(data)
 }
 
+// ParseFS reads and parses a task list file from a filesystem such as an
+// embed.FS, without touching the OS filesystem.
+func ParseFS(fsys fs.FS, name string) (*TaskList, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+	}
+	return Parse(data)
+}
+
+// ParseFileContext reads and parses a TASKS.json file, stopping early if ctx
+// is cancelled or its deadline expires during the read.
+func ParseFileContext(ctx context.Context, path string) (*TaskList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadFile, err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+	}
+	defer f.Close()
+	return ParseReaderContext(ctx, f)
+}
+
+// ParseReaderContext reads and parses a task list from r, stopping early if
+// ctx is cancelled or its deadline expires during the read. The returned
+// error wraps both ErrReadFile and ctx.Err() when the read is cancelled.
+func ParseReaderContext(ctx context.Context, r io.Reader) (*TaskList, error) {
+	data, err := io.ReadAll(&contextReader{ctx: ctx, r: r})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("%w: %w", ErrReadFile, ctxErr)
+		}
+		return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadFile, err)
+	}
+	return Parse(data)
+}
+
+// contextReader is an io.Reader that checks for cancellation before each read.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
+
 // Parse parses JSON data into a TaskList.
 func Parse(data []byte) (*TaskList, error) {
 	var tl TaskList
@@ -24,6 +84,43 @@ func Parse(data []byte) (*TaskList, error) {
 	return &tl, nil
 }
 
+// ParseAndValidate parses and validates a task list. If the document declares
+// an IR version other than the supported one, it returns an *IRVersionError
+// without running further validation. Other validation failures are returned
+// as the result of ValidationResult.Err. The parsed task list is returned
+// along with validation errors so callers can inspect or migrate it.
+func ParseAndValidate(data []byte) (*TaskList, error) {
+	tl, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if tl.IRVersion != "" && tl.IRVersion != schema.SchemaVersion() {
+		return tl, &IRVersionError{Version: tl.IRVersion, Supported: schema.SchemaVersion()}
+	}
+	return tl, Validate(tl).Err()
+}
+
+// LoadLegend reads a legend file shared across task lists. The file is a JSON
+// object mapping status values to legend entries, in the same form as the
+// task list's legend field. It returns an error wrapping ErrInvalidStatus if
+// a key is not a valid status.
+func LoadLegend(path string) (map[Status]LegendEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReadFile, err)
+	}
+	var legend map[Status]LegendEntry
+	if err := json.Unmarshal(data, &legend); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrParseJSON, err)
+	}
+	for status := range legend {
+		if !isValidStatus(status) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidStatus, status)
+		}
+	}
+	return legend, nil
+}
+
 // WriteFile writes a TaskList to a JSON file.
 func WriteFile(path string, tl *TaskList) error {
 	data, err := json.MarshalIndent(tl, "", "  ")
@@ -36,7 +133,65 @@ func WriteFile(path string, tl *TaskList) error {
 	return nil
 }
 
-// ToJSON converts a TaskList to JSON bytes.
+// WriteFileAtomic writes a TaskList to a JSON file by writing a temporary
+// file in the same directory and renaming it into place, so a crash mid-write
+// never leaves a partially written file. The existing file's permissions are
+// preserved; new files are created with mode 0600.
+func WriteFileAtomic(path string, tl *TaskList) error {
+	data, err := json.MarshalIndent(tl, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+
+	mode := os.FileMode(0600)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	tmpPath := tmp.Name()
+	cleanup := func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmpPath)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		cleanup()
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("%w: %v", ErrWriteFile, err)
+	}
+	return nil
+}
+
+// ToJSON converts a TaskList to JSON bytes indented with two spaces.
 func ToJSON(tl *TaskList) ([]byte, error) {
-	return json.MarshalIndent(tl, "", "  ")
+	return ToJSONIndent(tl, "  ")
+}
+
+// ToJSONIndent converts a TaskList to JSON bytes using the given indent.
+func ToJSONIndent(tl *TaskList, indent string) ([]byte, error) {
+	return json.MarshalIndent(tl, "", indent)
+}
+
+// ToJSONCompact converts a TaskList to JSON bytes without whitespace.
+func ToJSONCompact(tl *TaskList) ([]byte, error) {
+	return json.Marshal(tl)
 }
@@ -0,0 +1,156 @@
+package tasks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// ParsePartial parses as much of a task list as possible, for live
+// validation in editors. Unlike Parse, it does not stop at the first
+// structural problem: fields with the wrong JSON type are left at their zero
+// value, malformed tasks, areas, subtasks, and links are skipped, and each
+// problem is reported as a ValidationError on its field path. If data is not
+// syntactically valid JSON, nothing can be recovered and the task list is nil.
+// ParsePartial does not run Validate.
+func ParsePartial(data []byte) (*TaskList, []ValidationError) {
+	var result ValidationResult
+	if err := json.Unmarshal(data, new(json.RawMessage)); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			result.addError("", fmt.Sprintf("invalid JSON at offset %d: %v", syntaxErr.Offset, syntaxErr))
+		} else {
+			result.addError("", fmt.Sprintf("invalid JSON: %v", err))
+		}
+		return nil, result.Errors
+	}
+
+	var tl TaskList
+	decodePartial(data, reflect.ValueOf(&tl).Elem(), "", &result)
+	return &tl, result.Errors
+}
+
+// decodePartial decodes raw into v, recursing into structs and into slices
+// and maps of structs so that a problem in one element does not discard its
+// siblings. Problems are recorded on result under field paths built from
+// path, in the same form as Validate.
+func decodePartial(raw json.RawMessage, v reflect.Value, path string, result *ValidationResult) {
+	switch {
+	case v.Kind() == reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			result.addError(path, partialMessage(err))
+			return
+		}
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			if fieldRaw, ok := fields[name]; ok {
+				decodePartial(fieldRaw, v.Field(i), joinFieldPath(path, camelToSnake(name)), result)
+			}
+		}
+
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Struct:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			result.addError(path, partialMessage(err))
+			return
+		}
+		if elems == nil {
+			return
+		}
+		slice := reflect.MakeSlice(v.Type(), 0, len(elems))
+		for i, elemRaw := range elems {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			before := len(result.Errors)
+			decodePartial(elemRaw, elem, fmt.Sprintf("%s[%d]", path, i), result)
+			// Keep elements that decoded as objects, even with bad fields.
+			if len(result.Errors) == before || result.Errors[before].Field != fmt.Sprintf("%s[%d]", path, i) {
+				slice = reflect.Append(slice, elem)
+			}
+		}
+		v.Set(slice)
+
+	case v.Kind() == reflect.Map && v.Type().Elem().Kind() == reflect.Struct:
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			result.addError(path, partialMessage(err))
+			return
+		}
+		if entries == nil {
+			return
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(entries))
+		for _, key := range slices.Sorted(maps.Keys(entries)) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			decodePartial(entries[key], elem, path+"."+key, result)
+			m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		v.Set(m)
+
+	default:
+		if err := json.Unmarshal(raw, v.Addr().Interface()); err != nil {
+			result.addError(path, partialMessage(err))
+		}
+	}
+}
+
+// partialMessage describes a JSON decoding error in terms of JSON types.
+func partialMessage(err error) string {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("invalid type: expected %s, got %s", jsonKind(typeErr.Type), typeErr.Value)
+	}
+	return err.Error()
+}
+
+// jsonKind returns the JSON type name that decodes into t.
+func jsonKind(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return t.String()
+	}
+}
+
+// joinFieldPath appends name to a dotted field path.
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// camelToSnake converts a camelCase JSON name to the snake_case form used in
+// validation field paths, such as "dependsOn" to "depends_on".
+func camelToSnake(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			sb.WriteByte('_')
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
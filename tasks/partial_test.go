@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParsePartial(t *testing.T) {
+	data := []byte(`{
+		"irVersion": "1.0",
+		"project": 42,
+		"areas": [{"id": "core", "name": "Core"}, "oops"],
+		"legend": {"completed": {"emoji": 1, "description": "Done"}},
+		"tasks": [
+			{"id": "task-1", "title": "Good", "status": "planned", "dependsOn": "task-2"},
+			{"id": "task-2", "title": "Bad phase", "status": "planned", "phase": "one",
+			 "subtasks": [{"description": "ok"}, {"description": "bad", "completed": "yes"}]},
+			7
+		]
+	}`)
+
+	tl, errs := ParsePartial(data)
+	if tl == nil {
+		t.Fatal("ParsePartial() returned nil task list")
+	}
+
+	got := make(map[string]string)
+	for _, e := range errs {
+		got[e.Field] = e.Message
+	}
+	for field, message := range map[string]string{
+		"project":                        "invalid type: expected string, got number",
+		"areas[1]":                       "invalid type: expected object, got string",
+		"legend.completed.emoji":         "invalid type: expected string, got number",
+		"tasks[0].depends_on":            "invalid type: expected array, got string",
+		"tasks[1].phase":                 "invalid type: expected integer, got string",
+		"tasks[1].subtasks[1].completed": "invalid type: expected boolean, got string",
+		"tasks[2]":                       "invalid type: expected object, got number",
+	} {
+		if got[field] != message {
+			t.Errorf("error on %s = %q, want %q", field, got[field], message)
+		}
+	}
+	if len(errs) != 7 {
+		t.Errorf("ParsePartial() returned %d errors, want 7: %v", len(errs), errs)
+	}
+
+	if tl.IRVersion != "1.0" {
+		t.Errorf("IRVersion = %q, want 1.0", tl.IRVersion)
+	}
+	if len(tl.Areas) != 1 || tl.Areas[0].ID != "core" {
+		t.Errorf("Areas = %v, want only core", tl.Areas)
+	}
+	if tl.Legend[StatusCompleted].Description != "Done" {
+		t.Errorf("Legend[completed] = %v, want description recovered", tl.Legend[StatusCompleted])
+	}
+	if len(tl.Tasks) != 2 || tl.Tasks[1].Title != "Bad phase" || len(tl.Tasks[1].Subtasks) != 2 {
+		t.Errorf("Tasks = %+v, want two recovered tasks with subtasks", tl.Tasks)
+	}
+	if errs[0].Pointer == "" && errs[0].Field != "" {
+		t.Errorf("Expected JSON Pointers on errors, got %v", errs[0])
+	}
+}
+
+func TestParsePartialSyntaxError(t *testing.T) {
+	tl, errs := ParsePartial([]byte(`{"project": "p",`))
+	if tl != nil {
+		t.Errorf("ParsePartial() task list = %v, want nil for invalid JSON", tl)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, "invalid JSON") {
+		t.Errorf("ParsePartial() errors = %v, want one invalid JSON error", errs)
+	}
+}
+
+func TestParsePartialValid(t *testing.T) {
+	data := []byte(`{"irVersion": "1.0", "project": "p", "tasks": [{"id": "a", "title": "A", "status": "planned", "dependsOn": []}]}`)
+	tl, errs := ParsePartial(data)
+	if len(errs) != 0 {
+		t.Fatalf("ParsePartial() errors = %v, want none", errs)
+	}
+	want, _ := Parse(data)
+	if !Equal(tl, want) {
+		t.Errorf("ParsePartial() = %+v, want %+v", tl, want)
+	}
+}
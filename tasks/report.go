@@ -0,0 +1,70 @@
+package tasks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Severity values used when reporting validation results.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// reportEntry is a single validation finding in machine-readable form.
+type reportEntry struct {
+	Severity string `json:"severity"`
+	Field    string `json:"field"`
+	Pointer  string `json:"pointer,omitempty"`
+	Message  string `json:"message"`
+}
+
+// ToJSONL returns the validation errors and warnings as line-delimited JSON,
+// one object per finding with severity, field, pointer, and message. Errors
+// are emitted before warnings. The result is empty if there are no findings.
+func (r ValidationResult) ToJSONL() []byte {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	r.each(func(severity string, e ValidationError) {
+		// Encoding a struct of strings cannot fail.
+		_ = enc.Encode(reportEntry{Severity: severity, Field: e.Field, Pointer: e.Pointer, Message: e.Message})
+	})
+	return buf.Bytes()
+}
+
+// ToGitHubAnnotations returns the validation errors and warnings as GitHub
+// Actions workflow commands (::error and ::warning), one per line, attributed
+// to file. The field path is used as the annotation title.
+func (r ValidationResult) ToGitHubAnnotations(file string) string {
+	var sb strings.Builder
+	r.each(func(severity string, e ValidationError) {
+		fmt.Fprintf(&sb, "::%s file=%s,title=%s::%s\n",
+			severity,
+			escapeAnnotationProperty(file),
+			escapeAnnotationProperty(e.Field),
+			escapeAnnotationData(e.Message))
+	})
+	return sb.String()
+}
+
+// each calls fn for every error and then every warning.
+func (r ValidationResult) each(fn func(severity string, e ValidationError)) {
+	for _, e := range r.Errors {
+		fn(SeverityError, e)
+	}
+	for _, w := range r.Warnings {
+		fn(SeverityWarning, w)
+	}
+}
+
+// escapeAnnotationData escapes a workflow command message.
+func escapeAnnotationData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeAnnotationProperty escapes a workflow command property value.
+func escapeAnnotationProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
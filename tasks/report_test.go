@@ -0,0 +1,51 @@
+package tasks
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestValidationResultToJSONL(t *testing.T) {
+	result := ValidationResult{}
+	result.addWarning("tasks[1].title", "duplicate title")
+	result.addError("tasks[0].depends_on", "references unknown task: x")
+
+	scanner := bufio.NewScanner(bytes.NewReader(result.ToJSONL()))
+	var entries []map[string]string
+	for scanner.Scan() {
+		var entry map[string]string
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("ToJSONL() produced %d lines, want 2", len(entries))
+	}
+	if entries[0]["severity"] != SeverityError || entries[0]["pointer"] != "/tasks/0/dependsOn" {
+		t.Errorf("first entry = %v, want error with pointer /tasks/0/dependsOn", entries[0])
+	}
+	if entries[1]["severity"] != SeverityWarning || entries[1]["field"] != "tasks[1].title" {
+		t.Errorf("second entry = %v, want warning on tasks[1].title", entries[1])
+	}
+
+	if out := (ValidationResult{Valid: true}).ToJSONL(); len(out) != 0 {
+		t.Errorf("ToJSONL() on empty result = %q, want empty", out)
+	}
+}
+
+func TestValidationResultToGitHubAnnotations(t *testing.T) {
+	result := ValidationResult{}
+	result.addError("tasks[0].id", "required field is missing")
+	result.addWarning("tasks[1].title", "50% done\nsecond line")
+
+	got := result.ToGitHubAnnotations("TASKS.json")
+	want := "::error file=TASKS.json,title=tasks[0].id::required field is missing\n" +
+		"::warning file=TASKS.json,title=tasks[1].title::50%25 done%0Asecond line\n"
+	if got != want {
+		t.Errorf("ToGitHubAnnotations() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+package tasks
+
+import (
+	"sort"
+	"strings"
+)
+
+// Search returns tasks matching a case-insensitive free-text query. The query
+// is split into words and a task matches only if every word appears in its
+// title, description, or subtask descriptions. Results are ranked by where a
+// word first hits: title matches first, then description, then subtasks, with
+// ties kept in task list order. An empty slice is returned when nothing matches.
+func (tl *TaskList) Search(query string) []Task {
+	words := strings.Fields(strings.ToLower(query))
+	result := []Task{}
+	if len(words) == 0 {
+		return result
+	}
+
+	type hit struct {
+		task Task
+		rank int
+	}
+	var hits []hit
+	for _, task := range tl.Tasks {
+		fields := []string{strings.ToLower(task.Title), strings.ToLower(task.Description)}
+		var subtasks []string
+		for _, subtask := range task.Subtasks {
+			subtasks = append(subtasks, strings.ToLower(subtask.Description))
+		}
+		fields = append(fields, strings.Join(subtasks, "\n"))
+
+		rank := len(fields)
+		matched := true
+		for _, word := range words {
+			found := false
+			for i, field := range fields {
+				if strings.Contains(field, word) {
+					found = true
+					if i < rank {
+						rank = i
+					}
+					break
+				}
+			}
+			if !found {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			hits = append(hits, hit{task: task, rank: rank})
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].rank < hits[j].rank
+	})
+	for _, h := range hits {
+		result = append(result, h.task)
+	}
+	return result
+}
+
+// DuplicateTitles returns tasks that share a title, compared case-insensitively
+// after trimming whitespace. Keys are the lowercased titles and values are the
+// IDs of the tasks sharing that title, in task list order. Only titles shared
+// by two or more tasks are included.
+func (tl *TaskList) DuplicateTitles() map[string][]string {
+	byTitle := make(map[string][]string)
+	for _, task := range tl.Tasks {
+		key := strings.ToLower(strings.TrimSpace(task.Title))
+		if key == "" {
+			continue
+		}
+		byTitle[key] = append(byTitle[key], task.ID)
+	}
+	result := make(map[string][]string)
+	for title, ids := range byTitle {
+		if len(ids) > 1 {
+			result[title] = ids
+		}
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "sub", Title: "Misc", Subtasks: []Subtask{{Description: "Add OAuth login"}}},
+			{ID: "desc", Title: "Security", Description: "Support OAuth providers"},
+			{ID: "title", Title: "OAuth Login", Description: "Sign in with providers"},
+			{ID: "none", Title: "Rate limiting"},
+		},
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"oauth", []string{"title", "desc", "sub"}},
+		{"OAUTH login", []string{"title", "sub"}},
+		{"oauth providers", []string{"title", "desc"}},
+		{"missing", []string{}},
+		{"   ", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			result := tl.Search(tt.query)
+			if result == nil {
+				t.Fatal("Search() returned nil, want empty slice")
+			}
+			if got := taskIDs(result); !equalIDs(got, tt.want) {
+				t.Errorf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicateTitles(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "a", Title: "Add login", Status: StatusPlanned},
+			{ID: "b", Title: "Rate limiting", Status: StatusPlanned},
+			{ID: "c", Title: "add LOGIN ", Status: StatusPlanned},
+			{ID: "d", Title: "Add login", Status: StatusPlanned},
+		},
+	}
+
+	dups := tl.DuplicateTitles()
+	if len(dups) != 1 || !equalIDs(dups["add login"], []string{"a", "c", "d"}) {
+		t.Errorf("DuplicateTitles() = %v", dups)
+	}
+
+	result := Validate(tl)
+	if !result.Valid {
+		t.Errorf("Duplicate titles should not invalidate, got %v", result.Errors)
+	}
+	var dupWarnings []ValidationError
+	for _, w := range result.Warnings {
+		if strings.HasPrefix(w.Message, "duplicate title") {
+			dupWarnings = append(dupWarnings, w)
+		}
+	}
+	if len(dupWarnings) != 1 || dupWarnings[0].Field != "tasks[0].title" {
+		t.Errorf("Expected one duplicate title warning on tasks[0].title, got %v", result.Warnings)
+	}
+}
@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Subset returns a new task list containing only tasks matching the
+// predicate, in their original order. Areas no longer referenced by any
+// remaining task are pruned, and DependsOn, Blocks, and After references to
+// excluded tasks are dropped. The original task list is not modified.
+func (tl *TaskList) Subset(predicate func(Task) bool) *TaskList {
+	result, _ := tl.subset(predicate, false)
+	return result
+}
+
+// FilterStatuses returns a new task list containing only tasks with one of
+// the given statuses, such as a completed-only view for a changelog-style
+// document. It is built on Subset, so unused areas are pruned and the
+// original task list is not modified.
+func (tl *TaskList) FilterStatuses(statuses ...Status) *TaskList {
+	return tl.Subset(func(task Task) bool {
+		return slices.Contains(statuses, task.Status)
+	})
+}
+
+// SubsetStrict is like Subset but returns an error wrapping
+// ErrInvalidReference if a remaining task depends on an excluded task.
+func (tl *TaskList) SubsetStrict(predicate func(Task) bool) (*TaskList, error) {
+	return tl.subset(predicate, true)
+}
+
+func (tl *TaskList) subset(predicate func(Task) bool, strict bool) (*TaskList, error) {
+	kept := make(map[string]bool)
+	var matched []Task
+	for _, task := range tl.Tasks {
+		if predicate(task) {
+			matched = append(matched, task)
+			kept[task.ID] = true
+		}
+	}
+
+	result := &TaskList{
+		IRVersion: tl.IRVersion,
+		Project:   tl.Project,
+		Legend:    maps.Clone(tl.Legend),
+		Metadata:  maps.Clone(tl.Metadata),
+	}
+
+	usedAreas := make(map[string]bool)
+	for _, task := range matched {
+		if strict {
+			for _, dep := range task.DependsOn {
+				if !kept[dep] {
+					return nil, fmt.Errorf("%w: task %s depends on excluded task %s", ErrInvalidReference, task.ID, dep)
+				}
+			}
+		}
+		task.Subtasks = slices.Clone(task.Subtasks)
+		task.Links = slices.Clone(task.Links)
+		task.Owners = slices.Clone(task.Owners)
+		task.History = slices.Clone(task.History)
+		task.DependsOn = filterIDs(task.DependsOn, kept)
+		task.Blocks = filterIDs(task.Blocks, kept)
+		task.After = filterIDs(task.After, kept)
+		if task.Area != "" {
+			usedAreas[task.Area] = true
+		}
+		result.Tasks = append(result.Tasks, task)
+	}
+
+	for _, area := range tl.Areas {
+		if usedAreas[area.ID] {
+			result.Areas = append(result.Areas, area)
+		}
+	}
+	return result, nil
+}
+
+// filterIDs returns the IDs present in keep, or nil if none remain.
+func filterIDs(ids []string, keep map[string]bool) []string {
+	var result []string
+	for _, id := range ids {
+		if keep[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
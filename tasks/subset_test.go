@@ -0,0 +1,91 @@
+package tasks
+
+import (
+	"errors"
+	"testing"
+)
+
+func subsetFixture() *TaskList {
+	return &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Areas: []Area{
+			{ID: "core", Name: "Core"},
+			{ID: "api", Name: "API"},
+			{ID: "ui", Name: "UI"},
+		},
+		Tasks: []Task{
+			{ID: "core-1", Title: "Core", Status: StatusCompleted, Area: "core"},
+			{ID: "api-1", Title: "API", Status: StatusPlanned, Area: "api", DependsOn: []string{"core-1"}, Blocks: []string{"ui-1"}},
+			{ID: "ui-1", Title: "UI", Status: StatusPlanned, Area: "ui", DependsOn: []string{"api-1"}},
+		},
+	}
+}
+
+func TestSubset(t *testing.T) {
+	tl := subsetFixture()
+	sub := tl.Subset(func(task Task) bool { return task.Status == StatusPlanned })
+
+	if got := taskIDs(sub.Tasks); !equalIDs(got, []string{"api-1", "ui-1"}) {
+		t.Errorf("Subset tasks = %v", got)
+	}
+	if len(sub.Areas) != 2 || sub.Areas[0].ID != "api" || sub.Areas[1].ID != "ui" {
+		t.Errorf("Subset areas = %v, want api and ui", sub.Areas)
+	}
+	if len(sub.Tasks[0].DependsOn) != 0 {
+		t.Errorf("Expected dependency on excluded task to be dropped, got %v", sub.Tasks[0].DependsOn)
+	}
+	if !equalIDs(sub.Tasks[0].Blocks, []string{"ui-1"}) {
+		t.Errorf("Expected Blocks to keep included task, got %v", sub.Tasks[0].Blocks)
+	}
+	if !equalIDs(sub.Tasks[1].DependsOn, []string{"api-1"}) {
+		t.Errorf("Expected dependency on included task to be kept, got %v", sub.Tasks[1].DependsOn)
+	}
+	if result := Validate(sub); !result.Valid {
+		t.Errorf("Expected subset to be valid, got %v", result.Errors)
+	}
+
+	// The original is untouched.
+	if !Equal(tl, subsetFixture()) {
+		t.Error("Subset() modified the original task list")
+	}
+}
+
+func TestSubsetStrict(t *testing.T) {
+	tl := subsetFixture()
+
+	_, err := tl.SubsetStrict(func(task Task) bool { return task.Area == "ui" })
+	if !errors.Is(err, ErrInvalidReference) {
+		t.Errorf("Expected ErrInvalidReference, got %v", err)
+	}
+
+	sub, err := tl.SubsetStrict(func(task Task) bool { return task.Area != "ui" })
+	if err != nil {
+		t.Fatalf("SubsetStrict() error = %v", err)
+	}
+	if len(sub.Tasks) != 2 {
+		t.Errorf("Expected 2 tasks, got %d", len(sub.Tasks))
+	}
+}
+
+func TestFilterStatuses(t *testing.T) {
+	tl := &TaskList{
+		Areas: []Area{{ID: "core", Name: "Core"}, {ID: "docs", Name: "Docs"}},
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted, Area: "core"},
+			{ID: "2", Status: StatusPlanned, Area: "docs"},
+			{ID: "3", Status: StatusInProgress, Area: "core"},
+		},
+	}
+
+	view := tl.FilterStatuses(StatusCompleted, StatusInProgress)
+	if got := taskIDs(view.Tasks); !equalIDs(got, []string{"1", "3"}) {
+		t.Errorf("FilterStatuses() tasks = %v, want [1 3]", got)
+	}
+	if len(view.Areas) != 1 || view.Areas[0].ID != "core" {
+		t.Errorf("FilterStatuses() areas = %v, want only core", view.Areas)
+	}
+	if len(tl.Tasks) != 3 {
+		t.Error("FilterStatuses() should not modify the original task list")
+	}
+}
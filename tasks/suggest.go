@@ -0,0 +1,47 @@
+package tasks
+
+import "fmt"
+
+// didYouMean returns a " (did you mean X?)" suffix naming the known ID
+// closest to ref, or "" if no ID is a confident match. A match must be within
+// a small edit distance relative to the length of ref and strictly closer
+// than every other ID.
+func didYouMean(ref string, known map[string]bool) string {
+	threshold := min(3, max(1, len([]rune(ref))/3))
+	best, bestDist, tie := "", threshold+1, false
+	for id := range known {
+		d := levenshtein(ref, id)
+		switch {
+		case d < bestDist:
+			best, bestDist, tie = id, d, false
+		case d == bestDist:
+			tie = true
+		}
+	}
+	if best == "" || tie {
+		return ""
+	}
+	return fmt.Sprintf(" (did you mean %s?)", best)
+}
+
+// levenshtein returns the edit distance between a and b in runes.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,71 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"task-1", "tsak-1", 2},
+		{"ünï", "uni", 2},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	known := map[string]bool{"auth-login": true, "auth-logout": true, "search": true, "task-1": true, "task-2": true}
+
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"serch", " (did you mean search?)"},
+		{"auth-logn", " (did you mean auth-login?)"},
+		{"task-3", ""},  // equally close to task-1 and task-2
+		{"billing", ""}, // nothing close
+		{"x", ""},
+	}
+	for _, tt := range tests {
+		if got := didYouMean(tt.ref, known); got != tt.want {
+			t.Errorf("didYouMean(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSuggestions(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Areas:     []Area{{ID: "core", Name: "Core"}},
+		Tasks: []Task{
+			{ID: "setup", Title: "Setup", Status: StatusPlanned, Area: "cor"},
+			{ID: "feature", Title: "Feature", Status: StatusPlanned, DependsOn: []string{"stup"}},
+		},
+	}
+
+	result := Validate(tl)
+	var messages []string
+	for _, e := range result.Errors {
+		messages = append(messages, e.Message)
+	}
+	joined := strings.Join(messages, "\n")
+	for _, want := range []string{
+		"references unknown task: stup (did you mean setup?)",
+		"references unknown area: cor (did you mean core?)",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("Expected %q in errors, got\n%s", want, joined)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package tasks
 
 import (
+	"context"
 	"errors"
+	"io"
+	"os"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestParse(t *testing.T) {
@@ -313,6 +317,44 @@ func TestStats(t *testing.T) {
 	}
 }
 
+func TestAggregateStats(t *testing.T) {
+	a := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted, Area: "core", Type: "Added", Phase: 1},
+			{ID: "2", Status: StatusPlanned, Area: "api"},
+		},
+	}
+	b := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted, Area: "core", Type: "Fixed", Phase: 1},
+			{ID: "2", Status: StatusInProgress},
+		},
+	}
+
+	stats := AggregateStats(a, nil, b)
+
+	if stats.Total != 4 {
+		t.Errorf("Total = %d, want 4", stats.Total)
+	}
+	if stats.CompletedCount() != 2 {
+		t.Errorf("CompletedCount() = %d, want 2", stats.CompletedCount())
+	}
+	if stats.ByArea["core"] != 2 || stats.ByArea["api"] != 1 || len(stats.ByArea) != 2 {
+		t.Errorf("ByArea = %v, want map[api:1 core:2]", stats.ByArea)
+	}
+	if stats.ByType["Added"] != 1 || stats.ByType["Fixed"] != 1 {
+		t.Errorf("ByType = %v", stats.ByType)
+	}
+	if stats.ByPhase[1] != 2 || stats.ByPhase[0] != 2 {
+		t.Errorf("ByPhase = %v, want map[0:2 1:2]", stats.ByPhase)
+	}
+
+	empty := AggregateStats()
+	if empty.Total != 0 || empty.ByStatus == nil {
+		t.Errorf("Expected zero stats with initialized maps, got %+v", empty)
+	}
+}
+
 func TestTasksBy(t *testing.T) {
 	tl := &TaskList{
 		IRVersion: "1.0",
@@ -458,6 +500,129 @@ func TestGetStatusEmoji(t *testing.T) {
 	}
 }
 
+func TestDedupedDependsOn(t *testing.T) {
+	task := Task{DependsOn: []string{"b", "a", "b", "c", "a"}}
+	if got := task.DedupedDependsOn(); !equalIDs(got, []string{"b", "a", "c"}) {
+		t.Errorf("DedupedDependsOn() = %v, want [b a c]", got)
+	}
+	if got := (Task{}).DedupedDependsOn(); got != nil {
+		t.Errorf("DedupedDependsOn() on empty = %v, want nil", got)
+	}
+}
+
+func TestTaskSummary(t *testing.T) {
+	tests := []struct {
+		name   string
+		task   Task
+		maxLen int
+		want   string
+	}{
+		{"short description", Task{Title: "T", Description: "Add search"}, 20, "Add search"},
+		{"falls back to title", Task{Title: "Add search"}, 20, "Add search"},
+		{"word boundary", Task{Description: "Add full text search to the CLI"}, 20, "Add full text…"},
+		{"word ends at limit", Task{Description: "Add full text search to the CLI"}, 21, "Add full text search…"},
+		{"no boundary", Task{Description: "Supercalifragilistic"}, 10, "Supercali…"},
+		{"multibyte", Task{Description: "日本語のテキストです"}, 5, "日本語の…"},
+		{"emoji modifier", Task{Description: "👋🏽👋🏽👋🏽 hi"}, 4, "👋🏽…"},
+		{"trailing punctuation", Task{Description: "Fix bug, then ship it"}, 10, "Fix bug…"},
+		{"no limit", Task{Description: "Anything goes"}, 0, "Anything goes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.task.Summary(tt.maxLen)
+			if got != tt.want {
+				t.Errorf("Summary(%d) = %q, want %q", tt.maxLen, got, tt.want)
+			}
+			if tt.maxLen > 0 && len([]rune(got)) > tt.maxLen {
+				t.Errorf("Summary(%d) = %q is longer than %d runes", tt.maxLen, got, tt.maxLen)
+			}
+		})
+	}
+}
+
+func TestGroupTasks(t *testing.T) {
+	taskList := []Task{
+		{ID: "1", Status: StatusPlanned, Owners: []string{"alice"}},
+		{ID: "2", Status: StatusCompleted, Owners: []string{"alice"}},
+		{ID: "3", Status: StatusPlanned, Owners: []string{"alice"}},
+		{ID: "4", Status: StatusPlanned},
+	}
+
+	type ownerStatus struct {
+		Owner  string
+		Status Status
+	}
+	groups := GroupTasks(taskList, func(task Task) ownerStatus {
+		if len(task.Owners) == 0 {
+			return ownerStatus{Status: task.Status}
+		}
+		return ownerStatus{Owner: task.Owners[0], Status: task.Status}
+	})
+
+	if len(groups) != 3 {
+		t.Errorf("GroupTasks() returned %d groups, want 3", len(groups))
+	}
+	if got := taskIDs(groups[ownerStatus{"alice", StatusPlanned}]); !equalIDs(got, []string{"1", "3"}) {
+		t.Errorf("GroupTasks()[alice planned] = %v, want [1 3]", got)
+	}
+}
+
+func TestUncategorizedTasks(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Area: "core"},
+			{ID: "2"},
+			{ID: "3", Phase: 1},
+			{ID: "4", Type: "Added"},
+			{ID: "5"},
+		},
+	}
+
+	if got := taskIDs(tl.UncategorizedTasks()); !equalIDs(got, []string{"2", "5"}) {
+		t.Errorf("UncategorizedTasks() = %v, want [2 5]", got)
+	}
+}
+
+func TestWeightedCompletedPercent(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusCompleted},
+			{ID: "2", Status: StatusInProgress, Percent: 50},
+			{ID: "3", Status: StatusInProgress},
+			{ID: "4", Status: StatusPlanned},
+		},
+	}
+
+	if got := tl.WeightedCompletedPercent(); got != 37.5 {
+		t.Errorf("WeightedCompletedPercent() = %v, want 37.5", got)
+	}
+	if got := (&TaskList{}).WeightedCompletedPercent(); got != 0 {
+		t.Errorf("WeightedCompletedPercent() on empty = %v, want 0", got)
+	}
+}
+
+func TestTasksByOwner(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Owners: []string{"alice", "bob"}},
+			{ID: "2", Owners: []string{"bob", "bob"}},
+			{ID: "3"},
+		},
+	}
+
+	byOwner := tl.TasksByOwner()
+	if !equalIDs(taskIDs(byOwner["alice"]), []string{"1"}) {
+		t.Errorf("TasksByOwner[alice] = %v", taskIDs(byOwner["alice"]))
+	}
+	if !equalIDs(taskIDs(byOwner["bob"]), []string{"1", "2"}) {
+		t.Errorf("TasksByOwner[bob] = %v", taskIDs(byOwner["bob"]))
+	}
+	if !equalIDs(taskIDs(byOwner["_unassigned"]), []string{"3"}) {
+		t.Errorf("TasksByOwner[_unassigned] = %v", taskIDs(byOwner["_unassigned"]))
+	}
+}
+
 func TestTasksByType(t *testing.T) {
 	tl := &TaskList{
 		IRVersion: "1.0",
@@ -485,14 +650,56 @@ func TestTasksByType(t *testing.T) {
 
 func TestStatusOrder(t *testing.T) {
 	order := StatusOrder()
-	if len(order) != 4 {
-		t.Errorf("StatusOrder() returned %d items, want 4", len(order))
+	if len(order) != 5 {
+		t.Errorf("StatusOrder() returned %d items, want 5", len(order))
 	}
 	if order[0] != StatusInProgress {
 		t.Errorf("StatusOrder()[0] = %q, want %q", order[0], StatusInProgress)
 	}
-	if order[3] != StatusCompleted {
-		t.Errorf("StatusOrder()[3] = %q, want %q", order[3], StatusCompleted)
+	if order[1] != StatusBlocked {
+		t.Errorf("StatusOrder()[1] = %q, want %q", order[1], StatusBlocked)
+	}
+	if order[4] != StatusCompleted {
+		t.Errorf("StatusOrder()[4] = %q, want %q", order[4], StatusCompleted)
+	}
+}
+
+func TestStatusHelpers(t *testing.T) {
+	for i, status := range StatusOrder() {
+		if status.Order() != i {
+			t.Errorf("%s.Order() = %d, want %d", status, status.Order(), i)
+		}
+	}
+	if Status("bogus").Order() != len(StatusOrder()) {
+		t.Errorf("Unknown status should sort last, got %d", Status("bogus").Order())
+	}
+	for _, status := range StatusOrder() {
+		if !status.IsValid() {
+			t.Errorf("%s.IsValid() = false, want true", status)
+		}
+	}
+	if Status("in_progress").IsValid() {
+		t.Error("Status(in_progress).IsValid() = true, want false")
+	}
+
+	tests := []struct {
+		status   Status
+		terminal bool
+		active   bool
+	}{
+		{StatusCompleted, true, false},
+		{StatusInProgress, false, true},
+		{StatusBlocked, false, false},
+		{StatusPlanned, false, false},
+		{StatusFuture, false, false},
+	}
+	for _, tt := range tests {
+		if tt.status.IsTerminal() != tt.terminal {
+			t.Errorf("%s.IsTerminal() = %v, want %v", tt.status, tt.status.IsTerminal(), tt.terminal)
+		}
+		if tt.status.IsActive() != tt.active {
+			t.Errorf("%s.IsActive() = %v, want %v", tt.status, tt.status.IsActive(), tt.active)
+		}
 	}
 }
 
@@ -524,6 +731,74 @@ func TestToJSON(t *testing.T) {
 	}
 }
 
+func TestToJSONStampsIRVersion(t *testing.T) {
+	tl := &TaskList{Project: "test-project"}
+
+	data, err := ToJSON(tl)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"irVersion": "1.0"`) {
+		t.Errorf("Expected irVersion to be stamped, got:\n%s", data)
+	}
+	if tl.IRVersion != "" {
+		t.Error("ToJSON() should not modify the task list")
+	}
+
+	tl2, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result := Validate(tl2); !result.Valid {
+		t.Errorf("Expected round-tripped task list to be valid, got %v", result.Errors)
+	}
+
+	// An explicit version is preserved.
+	data, err = ToJSONCompact(&TaskList{IRVersion: "0.9", Project: "old"})
+	if err != nil {
+		t.Fatalf("ToJSONCompact() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"irVersion":"0.9"`) {
+		t.Errorf("Expected explicit irVersion to be preserved, got %s", data)
+	}
+}
+
+func TestToJSONIndentAndCompact(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test-project",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Test Task", Status: StatusCompleted},
+		},
+	}
+
+	indented, err := ToJSONIndent(tl, "\t")
+	if err != nil {
+		t.Fatalf("ToJSONIndent() error = %v", err)
+	}
+	if !strings.Contains(string(indented), "\n\t\"project\"") {
+		t.Errorf("Expected tab indentation, got:\n%s", indented)
+	}
+
+	compact, err := ToJSONCompact(tl)
+	if err != nil {
+		t.Fatalf("ToJSONCompact() error = %v", err)
+	}
+	if strings.ContainsAny(string(compact), "\n\t") || strings.Contains(string(compact), ": ") {
+		t.Errorf("Expected compact output without whitespace, got: %s", compact)
+	}
+
+	for _, data := range [][]byte{indented, compact} {
+		tl2, err := Parse(data)
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if !Equal(tl, tl2) {
+			t.Error("Expected round-trip to produce an equal task list")
+		}
+	}
+}
+
 func TestWriteFile(t *testing.T) {
 	tl := &TaskList{
 		IRVersion: "1.0",
@@ -548,6 +823,172 @@ func TestWriteFile(t *testing.T) {
 	}
 }
 
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/tasks.json"
+
+	tl := &TaskList{IRVersion: "1.0", Project: "first"}
+	if err := WriteFileAtomic(path, tl); err != nil {
+		t.Fatalf("WriteFileAtomic() error = %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	// Existing file permissions are preserved on overwrite.
+	if err := os.Chmod(path, 0640); err != nil {
+		t.Fatalf("Chmod() error = %v", err)
+	}
+	tl.Project = "second"
+	if err := WriteFileAtomic(path, tl); err != nil {
+		t.Fatalf("WriteFileAtomic() error = %v", err)
+	}
+	info, err = os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("Mode = %v, want 0640", info.Mode().Perm())
+	}
+
+	tl2, err := ParseFile(path)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+	if tl2.Project != "second" {
+		t.Errorf("Project = %q, want %q", tl2.Project, "second")
+	}
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Expected 1 file in directory, got %d", len(entries))
+	}
+
+	// Writing into a missing directory fails with ErrWriteFile.
+	err = WriteFileAtomic(dir+"/missing/tasks.json", tl)
+	if !errors.Is(err, ErrWriteFile) {
+		t.Errorf("Expected ErrWriteFile, got %v", err)
+	}
+}
+
+func TestLoadLegend(t *testing.T) {
+	dir := t.TempDir()
+
+	path := dir + "/legend.json"
+	if err := os.WriteFile(path, []byte(`{"completed": {"emoji": "🎉", "description": "Shipped"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	legend, err := LoadLegend(path)
+	if err != nil {
+		t.Fatalf("LoadLegend() error = %v", err)
+	}
+
+	tl := &TaskList{}
+	tl.SetLegend(legend)
+	merged := tl.GetLegend()
+	if merged[StatusCompleted].Emoji != "🎉" {
+		t.Errorf("GetLegend()[completed] = %v, want loaded entry", merged[StatusCompleted])
+	}
+	if merged[StatusPlanned] != DefaultLegend()[StatusPlanned] {
+		t.Errorf("GetLegend()[planned] = %v, want default entry", merged[StatusPlanned])
+	}
+
+	badPath := dir + "/bad.json"
+	if err := os.WriteFile(badPath, []byte(`{"done": {"emoji": "✅", "description": "Done"}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadLegend(badPath); !errors.Is(err, ErrInvalidStatus) {
+		t.Errorf("LoadLegend() with invalid key error = %v, want ErrInvalidStatus", err)
+	}
+
+	if _, err := LoadLegend(dir + "/missing.json"); !errors.Is(err, ErrReadFile) {
+		t.Errorf("LoadLegend() on missing file error = %v, want ErrReadFile", err)
+	}
+}
+
+func TestParseFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"examples/TASKS.json": {Data: []byte(`{"irVersion": "1.0", "project": "embedded"}`)},
+		"bad.json":            {Data: []byte(`{invalid}`)},
+	}
+
+	tl, err := ParseFS(fsys, "examples/TASKS.json")
+	if err != nil {
+		t.Fatalf("ParseFS() error = %v", err)
+	}
+	if tl.Project != "embedded" {
+		t.Errorf("Project = %q, want %q", tl.Project, "embedded")
+	}
+
+	if _, err := ParseFS(fsys, "missing.json"); !errors.Is(err, ErrReadFile) {
+		t.Errorf("Expected ErrReadFile, got %v", err)
+	}
+	if _, err := ParseFS(fsys, "bad.json"); !errors.Is(err, ErrParseJSON) {
+		t.Errorf("Expected ErrParseJSON, got %v", err)
+	}
+}
+
+func TestParseFileContext(t *testing.T) {
+	path := t.TempDir() + "/tasks.json"
+	if err := WriteFile(path, &TaskList{IRVersion: "1.0", Project: "test-project"}); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tl, err := ParseFileContext(context.Background(), path)
+	if err != nil {
+		t.Fatalf("ParseFileContext() error = %v", err)
+	}
+	if tl.Project != "test-project" {
+		t.Errorf("Project = %q, want %q", tl.Project, "test-project")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ParseFileContext(ctx, path)
+	if !errors.Is(err, ErrReadFile) || !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected ErrReadFile wrapping context.Canceled, got %v", err)
+	}
+
+	_, err = ParseFileContext(context.Background(), "/nonexistent/tasks.json")
+	if !errors.Is(err, ErrReadFile) {
+		t.Errorf("Expected ErrReadFile, got %v", err)
+	}
+}
+
+func TestParseReaderContextCancelledMidRead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &cancelAfterFirstRead{data: []byte(`{"irVersion": "1.0", "project": "test"}`), cancel: cancel}
+
+	_, err := ParseReaderContext(ctx, r)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// cancelAfterFirstRead returns one byte per read and cancels after the first.
+type cancelAfterFirstRead struct {
+	data   []byte
+	cancel context.CancelFunc
+}
+
+func (r *cancelAfterFirstRead) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	p[0] = r.data[0]
+	r.data = r.data[1:]
+	r.cancel()
+	return 1, nil
+}
+
 func TestParseError(t *testing.T) {
 	underlying := errors.New("connection refused")
 	parseErr := &ParseError{
@@ -570,6 +1011,38 @@ func TestParseError(t *testing.T) {
 	}
 }
 
+func TestParseAndValidate(t *testing.T) {
+	tl, err := ParseAndValidate([]byte(`{"irVersion": "1.0", "project": "p", "tasks": []}`))
+	if err != nil || tl == nil {
+		t.Fatalf("ParseAndValidate() = %v, %v; want task list and nil error", tl, err)
+	}
+
+	tl, err = ParseAndValidate([]byte(`{"irVersion": "0.9", "project": "p"}`))
+	var versionErr *IRVersionError
+	if !errors.As(err, &versionErr) {
+		t.Fatalf("ParseAndValidate() error = %v, want *IRVersionError", err)
+	}
+	if versionErr.Version != "0.9" || versionErr.Supported != "1.0" {
+		t.Errorf("IRVersionError = %+v, want Version 0.9 and Supported 1.0", versionErr)
+	}
+	if !errors.Is(err, ErrInvalidIRVersion) {
+		t.Error("Expected IRVersionError to wrap ErrInvalidIRVersion")
+	}
+	if tl == nil || tl.IRVersion != "0.9" {
+		t.Errorf("ParseAndValidate() should return the parsed task list with a version error")
+	}
+
+	_, err = ParseAndValidate([]byte(`{"irVersion": "1.0"}`))
+	var validationErr ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Field != "project" {
+		t.Errorf("ParseAndValidate() error = %v, want ValidationError on project", err)
+	}
+
+	if _, err := ParseAndValidate([]byte(`{`)); !errors.Is(err, ErrParseJSON) {
+		t.Errorf("ParseAndValidate() error = %v, want ErrParseJSON", err)
+	}
+}
+
 func TestValidationError(t *testing.T) {
 	err := ValidationError{
 		Field:   "ir_version",
@@ -693,3 +1166,20 @@ func TestValidateMoreCases(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStatusLabel(t *testing.T) {
+	tl := &TaskList{
+		Legend: map[Status]LegendEntry{
+			StatusPlanned: {Emoji: "📋", Description: "Next Up"},
+		},
+	}
+	if got := tl.GetStatusLabel(StatusPlanned); got != "Next Up" {
+		t.Errorf("GetStatusLabel(planned) = %q, want %q", got, "Next Up")
+	}
+	if got := tl.GetStatusLabel(StatusCompleted); got != "Completed" {
+		t.Errorf("GetStatusLabel(completed) = %q, want %q", got, "Completed")
+	}
+	if got := tl.GetStatusLabel("custom"); got != "custom" {
+		t.Errorf("GetStatusLabel(custom) = %q, want %q", got, "custom")
+	}
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import "fmt"
+
+// Transitions maps each status to the statuses a task may move to next.
+// Staying in the same status is always allowed and need not be listed.
+type Transitions map[Status][]Status
+
+// DefaultTransitions returns the default allowed status transitions, which
+// move forward one step at a time:
+//
+//	future -> planned -> inProgress -> completed
+//
+// Planned and in-progress tasks may also become blocked, and a blocked task
+// may return to planned or in-progress once unblocked. Moving backward or
+// skipping a step is not allowed. Callers that need a different workflow can
+// build their own Transitions and call Validate.
+func DefaultTransitions() Transitions {
+	return Transitions{
+		StatusFuture:     {StatusPlanned},
+		StatusPlanned:    {StatusInProgress, StatusBlocked},
+		StatusInProgress: {StatusCompleted, StatusBlocked},
+		StatusBlocked:    {StatusPlanned, StatusInProgress},
+		StatusCompleted:  {},
+	}
+}
+
+// Allows reports whether moving from one status to another is allowed.
+func (t Transitions) Allows(from, to Status) bool {
+	if from == to {
+		return true
+	}
+	for _, s := range t[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if moving from one status to another is not
+// allowed. Errors wrap ErrInvalidStatus for unknown statuses and
+// ErrInvalidTransition for disallowed moves.
+func (t Transitions) Validate(from, to Status) error {
+	if !isValidStatus(from) {
+		return fmt.Errorf("%w: %s", ErrInvalidStatus, from)
+	}
+	if !isValidStatus(to) {
+		return fmt.Errorf("%w: %s", ErrInvalidStatus, to)
+	}
+	if !t.Allows(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
+
+// ValidateTransition checks a status change against DefaultTransitions.
+func ValidateTransition(from, to Status) error {
+	return DefaultTransitions().Validate(from, to)
+}
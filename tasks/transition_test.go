@@ -0,0 +1,66 @@
+package tasks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		from, to Status
+		wantErr  error
+	}{
+		{StatusFuture, StatusPlanned, nil},
+		{StatusPlanned, StatusInProgress, nil},
+		{StatusInProgress, StatusCompleted, nil},
+		{StatusPlanned, StatusPlanned, nil},
+		{StatusPlanned, StatusCompleted, ErrInvalidTransition},
+		{StatusCompleted, StatusInProgress, ErrInvalidTransition},
+		{StatusInProgress, StatusFuture, ErrInvalidTransition},
+		{"bogus", StatusPlanned, ErrInvalidStatus},
+		{StatusPlanned, "bogus", ErrInvalidStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"->"+string(tt.to), func(t *testing.T) {
+			err := ValidateTransition(tt.from, tt.to)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("ValidateTransition() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateTransition() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomTransitions(t *testing.T) {
+	custom := DefaultTransitions()
+	custom[StatusPlanned] = append(custom[StatusPlanned], StatusCompleted)
+
+	if err := custom.Validate(StatusPlanned, StatusCompleted); err != nil {
+		t.Errorf("Expected custom transition to be allowed, got %v", err)
+	}
+	if err := ValidateTransition(StatusPlanned, StatusCompleted); err == nil {
+		t.Error("Expected default transitions to be unaffected by customization")
+	}
+}
+
+func TestValidateTransitionBlocked(t *testing.T) {
+	for _, tt := range []struct{ from, to Status }{
+		{StatusPlanned, StatusBlocked},
+		{StatusInProgress, StatusBlocked},
+		{StatusBlocked, StatusPlanned},
+		{StatusBlocked, StatusInProgress},
+	} {
+		if err := ValidateTransition(tt.from, tt.to); err != nil {
+			t.Errorf("ValidateTransition(%s, %s) error = %v", tt.from, tt.to, err)
+		}
+	}
+	if err := ValidateTransition(StatusBlocked, StatusCompleted); err == nil {
+		t.Error("Expected blocked -> completed to be disallowed")
+	}
+}
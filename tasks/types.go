@@ -2,20 +2,44 @@
 // The Type field uses category names from structured-changelog for consistency.
 package tasks
 
+import (
+	"encoding/json"
+	"maps"
+	"strings"
+	"unicode"
+
+	"github.com/grokify/structured-tasks/schema"
+)
+
 // Status represents the status of a task.
 type Status string
 
 const (
 	StatusInProgress Status = "inProgress"
+	StatusBlocked    Status = "blocked"
 	StatusPlanned    Status = "planned"
 	StatusFuture     Status = "future"
 	StatusCompleted  Status = "completed"
 )
 
+// Relative planning horizons, for teams that plan in Now / Next / Later
+// buckets rather than dates.
+const (
+	HorizonNow   = "now"
+	HorizonNext  = "next"
+	HorizonLater = "later"
+)
+
+// Horizons returns the valid horizon values in display order.
+func Horizons() []string {
+	return []string{HorizonNow, HorizonNext, HorizonLater}
+}
+
 // DefaultLegend returns the default status legend with emoji and descriptions.
 func DefaultLegend() map[Status]LegendEntry {
 	return map[Status]LegendEntry{
 		StatusInProgress: {Emoji: "🚧", Description: "In Progress"},
+		StatusBlocked:    {Emoji: "🚫", Description: "Blocked"},
 		StatusPlanned:    {Emoji: "📋", Description: "Planned"},
 		StatusFuture:     {Emoji: "💡", Description: "Under Consideration"},
 		StatusCompleted:  {Emoji: "✅", Description: "Completed"},
@@ -29,6 +53,19 @@ type TaskList struct {
 	Legend    map[Status]LegendEntry `json:"legend,omitempty"`
 	Areas     []Area                 `json:"areas,omitempty"`
 	Tasks     []Task                 `json:"tasks,omitempty"`
+	Metadata  map[string]string      `json:"metadata,omitempty"` // e.g., team, cost center, visibility
+}
+
+// MarshalJSON encodes the task list, defaulting an empty IRVersion to the
+// current schema version so programmatically built task lists round-trip
+// through Validate.
+func (tl TaskList) MarshalJSON() ([]byte, error) {
+	type taskList TaskList
+	out := taskList(tl)
+	if out.IRVersion == "" {
+		out.IRVersion = schema.SchemaVersion()
+	}
+	return json.Marshal(out)
 }
 
 // LegendEntry defines the emoji and description for a status.
@@ -45,18 +82,108 @@ type Area struct {
 
 // Task represents a work item (feature, task, improvement).
 // Order is determined by position in the Tasks array.
+// After lists tasks that should be displayed before this one; unlike
+// DependsOn, it is a display preference and does not block the task.
 // Type should be a valid category name from structured-changelog (e.g., "Added", "Fixed").
 type Task struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description,omitempty"`
-	Status      Status    `json:"status"`
-	Phase       int       `json:"phase,omitempty"`
-	Area        string    `json:"area,omitempty"`
-	Type        string    `json:"type,omitempty"`
-	DependsOn   []string  `json:"dependsOn,omitempty"`
-	Blocks      []string  `json:"blocks,omitempty"`
-	Subtasks    []Subtask `json:"subtasks,omitempty"`
+	ID            string      `json:"id"`
+	Title         string      `json:"title"`
+	Description   string      `json:"description,omitempty"`
+	Status        Status      `json:"status"`
+	Phase         int         `json:"phase,omitempty"`
+	Area          string      `json:"area,omitempty"`
+	Type          string      `json:"type,omitempty"`
+	DependsOn     []string    `json:"dependsOn,omitempty"`
+	Blocks        []string    `json:"blocks,omitempty"`
+	After         []string    `json:"after,omitempty"`
+	Subtasks      []Subtask   `json:"subtasks,omitempty"`
+	Links         []Link      `json:"links,omitempty"`
+	Owners        []string    `json:"owners,omitempty"`
+	EstimatedDays float64     `json:"estimatedDays,omitempty"` // person-days
+	Percent       int         `json:"percent,omitempty"`       // 0-100, for in-progress tasks
+	StartedDate   string      `json:"startedDate,omitempty"`   // ISO 8601 date or date-time
+	History       []TaskEvent `json:"history,omitempty"`
+	Horizon       string      `json:"horizon,omitempty"` // now, next, or later
+}
+
+// TaskEvent records a point in a task's own timeline, such as when it was
+// planned, started, or completed.
+type TaskEvent struct {
+	Date   string `json:"date"`   // ISO 8601 date or date-time
+	Status Status `json:"status"` // status the task entered
+	Note   string `json:"note,omitempty"`
+}
+
+// DedupedDependsOn returns the task's dependencies with duplicate IDs
+// removed, preserving the order of first occurrence.
+func (t Task) DedupedDependsOn() []string {
+	if t.DependsOn == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(t.DependsOn))
+	deps := make([]string, 0, len(t.DependsOn))
+	for _, dep := range t.DependsOn {
+		if seen[dep] {
+			continue
+		}
+		seen[dep] = true
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
+// Summary returns the task's description, or its title if the description
+// is empty, truncated to at most maxLen runes. Truncated text ends with an
+// ellipsis and is cut at a word boundary when one falls in the second half of
+// the allowed length. Cuts never split a multi-rune character such as an
+// emoji with a skin tone or joiner. A maxLen of zero or less disables
+// truncation.
+func (t Task) Summary(maxLen int) string {
+	text := strings.TrimSpace(t.Description)
+	if text == "" {
+		text = strings.TrimSpace(t.Title)
+	}
+	runes := []rune(text)
+	if maxLen <= 0 || len(runes) <= maxLen {
+		return text
+	}
+
+	cut := maxLen - 1 // leave room for the ellipsis
+	for cut > 0 && (continuesCluster(runes[cut]) || runes[cut-1] == '\u200d') {
+		cut--
+	}
+	if space := lastSpace(runes[:cut+1]); space > cut/2 {
+		cut = space
+	}
+	return strings.TrimRightFunc(string(runes[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+// continuesCluster reports whether r attaches to the preceding rune, such as
+// a combining mark, variation selector, joiner, or emoji skin tone modifier.
+func continuesCluster(r rune) bool {
+	return r == '\u200d' ||
+		(r >= '\ufe00' && r <= '\ufe0f') ||
+		(r >= 0x1f3fb && r <= 0x1f3ff) ||
+		unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r)
+}
+
+// lastSpace returns the index of the last whitespace rune, or -1 if none.
+func lastSpace(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Link is a labeled URL attached to a task, such as a design doc or pull request.
+type Link struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+	Rel   string `json:"rel,omitempty"` // e.g., "design", "tracking", "pr"
 }
 
 // Subtask represents a checkbox item within a task.
@@ -66,6 +193,12 @@ type Subtask struct {
 	Completed   bool   `json:"completed"`
 }
 
+// SetLegend replaces the task list's legend with a copy of legend, such as
+// one returned by LoadLegend. GetLegend still layers it over DefaultLegend.
+func (tl *TaskList) SetLegend(legend map[Status]LegendEntry) {
+	tl.Legend = maps.Clone(legend)
+}
+
 // GetLegend returns the task list's legend, falling back to defaults.
 func (tl *TaskList) GetLegend() map[Status]LegendEntry {
 	if len(tl.Legend) > 0 {
@@ -87,46 +220,93 @@ func (tl *TaskList) GetStatusEmoji(status Status) string {
 	return ""
 }
 
+// GetStatusLabel returns the legend description for a status, falling back
+// to the status value itself if the legend has no description for it.
+func (tl *TaskList) GetStatusLabel(status Status) string {
+	if entry, ok := tl.GetLegend()[status]; ok && entry.Description != "" {
+		return entry.Description
+	}
+	return string(status)
+}
+
+// GroupTasks groups tasks by the key returned by keyFn, preserving their
+// relative order within each group. It underlies the TasksBy* methods and
+// can be used to group by custom keys, such as an owner and status pair.
+func GroupTasks[K comparable](taskList []Task, keyFn func(Task) K) map[K][]Task {
+	result := make(map[K][]Task)
+	for _, task := range taskList {
+		key := keyFn(task)
+		result[key] = append(result[key], task)
+	}
+	return result
+}
+
 // TasksByArea returns tasks grouped by area.
 func (tl *TaskList) TasksByArea() map[string][]Task {
+	return GroupTasks(tl.Tasks, areaKey)
+}
+
+// TasksByOwner returns tasks grouped by owner. A task with several owners
+// appears under each of them; tasks without owners are grouped under
+// "_unassigned".
+func (tl *TaskList) TasksByOwner() map[string][]Task {
 	result := make(map[string][]Task)
 	for _, task := range tl.Tasks {
-		area := task.Area
-		if area == "" {
-			area = "_unspecified"
+		if len(task.Owners) == 0 {
+			result["_unassigned"] = append(result["_unassigned"], task)
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, owner := range task.Owners {
+			if owner == "" || seen[owner] {
+				continue
+			}
+			seen[owner] = true
+			result[owner] = append(result[owner], task)
 		}
-		result[area] = append(result[area], task)
 	}
 	return result
 }
 
+// TasksByHorizon returns tasks grouped by horizon. Tasks without a horizon
+// are grouped under "_unspecified".
+func (tl *TaskList) TasksByHorizon() map[string][]Task {
+	return GroupTasks(tl.Tasks, func(task Task) string {
+		if task.Horizon == "" {
+			return "_unspecified"
+		}
+		return task.Horizon
+	})
+}
+
 // TasksByType returns tasks grouped by change type.
 func (tl *TaskList) TasksByType() map[string][]Task {
-	result := make(map[string][]Task)
-	for _, task := range tl.Tasks {
-		t := task.Type
-		if t == "" {
-			t = "_unspecified"
+	return GroupTasks(tl.Tasks, func(task Task) string {
+		if task.Type == "" {
+			return "_unspecified"
 		}
-		result[t] = append(result[t], task)
-	}
-	return result
+		return task.Type
+	})
 }
 
 // TasksByPhase returns tasks grouped by phase number.
 func (tl *TaskList) TasksByPhase() map[int][]Task {
-	result := make(map[int][]Task)
-	for _, task := range tl.Tasks {
-		result[task.Phase] = append(result[task.Phase], task)
-	}
-	return result
+	return GroupTasks(tl.Tasks, func(task Task) int { return task.Phase })
 }
 
 // TasksByStatus returns tasks grouped by status.
 func (tl *TaskList) TasksByStatus() map[Status][]Task {
-	result := make(map[Status][]Task)
+	return GroupTasks(tl.Tasks, func(task Task) Status { return task.Status })
+}
+
+// UncategorizedTasks returns tasks that have no area, no phase, and no
+// change type, in their original order. These are the tasks needing triage.
+func (tl *TaskList) UncategorizedTasks() []Task {
+	var result []Task
 	for _, task := range tl.Tasks {
-		result[task.Status] = append(result[task.Status], task)
+		if task.Area == "" && task.Phase == 0 && task.Type == "" {
+			result = append(result, task)
+		}
 	}
 	return result
 }
@@ -162,6 +342,40 @@ type Stats struct {
 	ByPhase  map[int]int
 }
 
+// AggregateStats sums statistics across several task lists, merging the
+// breakdown maps by key. Areas and types are merged by ID, so the same ID in
+// different task lists is counted together. As with Stats, tasks without an
+// area or type are not counted in ByArea or ByType, and unphased tasks are
+// counted under phase 0. Nil task lists are skipped.
+func AggregateStats(taskLists ...*TaskList) Stats {
+	result := Stats{
+		ByStatus: make(map[Status]int),
+		ByArea:   make(map[string]int),
+		ByType:   make(map[string]int),
+		ByPhase:  make(map[int]int),
+	}
+	for _, tl := range taskLists {
+		if tl == nil {
+			continue
+		}
+		stats := tl.Stats()
+		result.Total += stats.Total
+		for k, v := range stats.ByStatus {
+			result.ByStatus[k] += v
+		}
+		for k, v := range stats.ByArea {
+			result.ByArea[k] += v
+		}
+		for k, v := range stats.ByType {
+			result.ByType[k] += v
+		}
+		for k, v := range stats.ByPhase {
+			result.ByPhase[k] += v
+		}
+	}
+	return result
+}
+
 // InProgressCount returns the number of in-progress tasks.
 func (s Stats) InProgressCount() int {
 	return s.ByStatus[StatusInProgress]
@@ -177,9 +391,67 @@ func (s Stats) CompletedCount() int {
 	return s.ByStatus[StatusCompleted]
 }
 
+// CompletedPercent returns the percentage of tasks that are completed,
+// or 0 if there are no tasks.
+func (s Stats) CompletedPercent() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.CompletedCount()) / float64(s.Total) * 100
+}
+
+// WeightedCompletedPercent returns the percentage of work completed, counting
+// completed tasks as done and in-progress tasks fractionally by their Percent
+// field. Other tasks count as not started. It returns 0 if there are no tasks.
+func (tl *TaskList) WeightedCompletedPercent() float64 {
+	if len(tl.Tasks) == 0 {
+		return 0
+	}
+	var done float64
+	for _, task := range tl.Tasks {
+		switch task.Status {
+		case StatusCompleted:
+			done++
+		case StatusInProgress:
+			done += float64(task.Percent) / 100
+		}
+	}
+	return done / float64(len(tl.Tasks)) * 100
+}
+
 // StatusOrder returns the canonical order of statuses for display.
+// Blocked follows in-progress and precedes planned: blocked work has usually
+// been committed to, and listing it near the top keeps it visible.
 func StatusOrder() []Status {
-	return []Status{StatusInProgress, StatusPlanned, StatusFuture, StatusCompleted}
+	return []Status{StatusInProgress, StatusBlocked, StatusPlanned, StatusFuture, StatusCompleted}
+}
+
+// Order returns the index of the status in StatusOrder. Unknown statuses
+// sort after all known statuses.
+func (s Status) Order() int {
+	order := StatusOrder()
+	for i, status := range order {
+		if status == s {
+			return i
+		}
+	}
+	return len(order)
+}
+
+// IsValid reports whether the status is one of the known statuses, as
+// checked by Validate.
+func (s Status) IsValid() bool {
+	return isValidStatus(s)
+}
+
+// IsTerminal returns true if no further work is expected for the status.
+func (s Status) IsTerminal() bool {
+	return s == StatusCompleted
+}
+
+// IsActive returns true if work is currently underway for the status.
+func (s Status) IsActive() bool {
+	return s == StatusInProgress
 }
 
 // PhaseNumbers returns sorted phase numbers from the task list.
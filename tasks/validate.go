@@ -1,14 +1,23 @@
 package tasks
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-tasks/schema"
 )
 
 // ValidationError represents a validation error.
 type ValidationError struct {
-	Field   string
+	Field   string // Field path (e.g., "tasks[0].id")
+	Pointer string // RFC 6901 JSON Pointer (e.g., "/tasks/0/id")
 	Message string
 }
 
@@ -17,25 +26,118 @@ func (e ValidationError) Error() string {
 }
 
 // ValidationResult holds the results of validation.
+// Warnings flag likely mistakes but do not make the result invalid.
 type ValidationResult struct {
-	Valid  bool
-	Errors []ValidationError
+	Valid    bool
+	Errors   []ValidationError
+	Warnings []ValidationError
 }
 
-// Validate checks a TaskList for validity.
+// Err returns nil if the result is valid, or the validation errors joined
+// with errors.Join. Warnings are not included.
+func (r ValidationResult) Err() error {
+	if r.Valid {
+		return nil
+	}
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = e
+	}
+	return errors.Join(errs...)
+}
+
+// TypeRegistry reports whether a change type name is valid.
+// *changelog.ChangeTypeRegistry satisfies this interface.
+type TypeRegistry interface {
+	IsValidName(name string) bool
+}
+
+// ValidateOptions configures optional validation behavior.
+type ValidateOptions struct {
+	// TypeRegistry validates task types. If nil, changelog.DefaultRegistry is used.
+	TypeRegistry TypeRegistry
+
+	// RequireDeclaredAreas requires every task area to reference a declared
+	// area, even when no areas are declared. By default, task areas are only
+	// checked when at least one area is declared.
+	RequireDeclaredAreas bool
+
+	// AreaTypePolicy restricts the change types allowed in an area, keyed by
+	// area ID. Tasks in a listed area whose type is not allowed get a
+	// warning. Tasks without a type and areas not listed are unrestricted.
+	AreaTypePolicy map[string][]string
+
+	// WarnEmpty adds a warning when the task list has no tasks and no areas,
+	// which usually means the file was truncated or generated incorrectly.
+	WarnEmpty bool
+
+	// MaxSubtasks limits the number of subtasks per task. Zero means no limit.
+	MaxSubtasks int
+
+	// MaxTextLength limits the length in characters of task titles and
+	// descriptions and of subtask descriptions. Zero means no limit.
+	MaxTextLength int
+}
+
+// Validate checks a TaskList for validity using default options.
 func Validate(tl *TaskList) ValidationResult {
+	return ValidateWith(tl, ValidateOptions{})
+}
+
+// ValidateWith checks a TaskList for validity using the given options.
+func ValidateWith(tl *TaskList, opts ValidateOptions) ValidationResult {
 	result := ValidationResult{Valid: true}
 
+	registry := opts.TypeRegistry
+	if registry == nil {
+		registry = changelog.DefaultRegistry
+	}
+
 	// Required fields
 	if tl.IRVersion == "" {
 		result.addError("ir_version", "required field is missing")
-	} else if tl.IRVersion != "1.0" {
+	} else if tl.IRVersion != schema.SchemaVersion() {
 		result.addError("ir_version", fmt.Sprintf("unsupported version: %s", tl.IRVersion))
 	}
 
 	if tl.Project == "" {
 		result.addError("project", "required field is missing")
 	}
+	result.checkTrimmed("project", tl.Project)
+
+	if _, ok := tl.Metadata[""]; ok {
+		result.addError("metadata", "metadata keys must not be empty")
+	}
+
+	if opts.WarnEmpty && len(tl.Tasks) == 0 && len(tl.Areas) == 0 {
+		result.addWarning("tasks", "task list contains no content")
+	}
+
+	// Validate legend. Unknown keys are ignored by GetLegend, so they are
+	// reported as warnings rather than errors.
+	knownStatuses := make(map[string]bool)
+	for _, status := range StatusOrder() {
+		knownStatuses[string(status)] = true
+	}
+	legendKeys := make([]string, 0, len(tl.Legend))
+	for status := range tl.Legend {
+		legendKeys = append(legendKeys, string(status))
+	}
+	sort.Strings(legendKeys)
+	for _, key := range legendKeys {
+		entry := tl.Legend[Status(key)]
+		prefix := "legend." + key
+		if !isValidStatus(Status(key)) {
+			result.addWarning(prefix, fmt.Sprintf("unknown status: %s%s", key, didYouMean(key, knownStatuses)))
+			continue
+		}
+		if entry.Emoji == "" {
+			result.addWarning(prefix+".emoji", "emoji is empty")
+		}
+		if entry.Description == "" {
+			result.addWarning(prefix+".description", "description is empty")
+		}
+	}
 
 	// Validate tasks
 	taskIDs := make(map[string]bool)
@@ -49,10 +151,15 @@ func Validate(tl *TaskList) ValidationResult {
 		} else {
 			taskIDs[task.ID] = true
 		}
+		result.checkID(prefix+".id", task.ID)
 
 		if task.Title == "" {
 			result.addError(prefix+".title", "required field is missing")
 		}
+		result.checkTrimmed(prefix+".title", task.Title)
+		result.checkID(prefix+".area", task.Area)
+		result.checkLength(prefix+".title", task.Title, opts.MaxTextLength)
+		result.checkLength(prefix+".description", task.Description, opts.MaxTextLength)
 
 		if task.Status == "" {
 			result.addError(prefix+".status", "required field is missing")
@@ -65,31 +172,121 @@ func Validate(tl *TaskList) ValidationResult {
 			result.addError(prefix+".phase", "phase must be non-negative")
 		}
 
+		if task.EstimatedDays < 0 {
+			result.addError(prefix+".estimated_days", "estimated days must be non-negative")
+		}
+
+		if task.StartedDate != "" {
+			if _, ok := parseDate(task.StartedDate); !ok {
+				result.addError(prefix+".started_date", fmt.Sprintf("invalid date: %s (expected YYYY-MM-DD or RFC 3339)", task.StartedDate))
+			}
+		}
+
+		if task.Percent < 0 || task.Percent > 100 {
+			result.addError(prefix+".percent", "percent must be between 0 and 100")
+		} else if task.Percent != 0 && task.Status != StatusInProgress {
+			result.addWarning(prefix+".percent", fmt.Sprintf("percent is only meaningful for inProgress tasks, not %s", task.Status))
+		}
+
+		if task.Horizon != "" && !slices.Contains(Horizons(), task.Horizon) {
+			result.addError(prefix+".horizon", fmt.Sprintf("invalid horizon: %s (expected now, next, or later)", task.Horizon))
+		}
+
 		// Validate type against structured-changelog change types
 		if task.Type != "" {
-			if !changelog.DefaultRegistry.IsValidName(task.Type) {
+			if !registry.IsValidName(task.Type) {
 				result.addError(prefix+".type", fmt.Sprintf("invalid change type: %s (see structured-changelog for valid types)", task.Type))
 			}
 		}
 
 		// Validate subtasks
+		if opts.MaxSubtasks > 0 && len(task.Subtasks) > opts.MaxSubtasks {
+			result.addError(prefix+".subtasks", fmt.Sprintf("too many subtasks: %d (max %d)", len(task.Subtasks), opts.MaxSubtasks))
+		}
 		for j, subtask := range task.Subtasks {
 			subtaskPrefix := fmt.Sprintf("%s.subtasks[%d]", prefix, j)
 			if subtask.Description == "" {
 				result.addError(subtaskPrefix+".description", "required field is missing")
 			}
+			result.checkLength(subtaskPrefix+".description", subtask.Description, opts.MaxTextLength)
+		}
+
+		// Validate owners
+		owners := make(map[string]bool)
+		for j, owner := range task.Owners {
+			ownerField := fmt.Sprintf("%s.owners[%d]", prefix, j)
+			if strings.TrimSpace(owner) == "" {
+				result.addError(ownerField, "owner must not be empty")
+			} else if owners[owner] {
+				result.addWarning(ownerField, fmt.Sprintf("duplicate owner: %s", owner))
+			}
+			owners[owner] = true
+		}
+
+		// Validate history
+		for j, event := range task.History {
+			eventPrefix := fmt.Sprintf("%s.history[%d]", prefix, j)
+			if event.Date == "" {
+				result.addError(eventPrefix+".date", "required field is missing")
+			} else if _, ok := parseDate(event.Date); !ok {
+				result.addError(eventPrefix+".date", fmt.Sprintf("invalid date: %s (expected YYYY-MM-DD or RFC 3339)", event.Date))
+			}
+			if event.Status == "" {
+				result.addError(eventPrefix+".status", "required field is missing")
+			} else if !isValidStatus(event.Status) {
+				result.addError(eventPrefix+".status", fmt.Sprintf("invalid status: %s", event.Status))
+			}
+		}
+
+		// Validate links
+		for j, link := range task.Links {
+			linkPrefix := fmt.Sprintf("%s.links[%d]", prefix, j)
+			if link.Label == "" {
+				result.addError(linkPrefix+".label", "required field is missing")
+			}
+			if link.URL == "" {
+				result.addError(linkPrefix+".url", "required field is missing")
+			} else if !isValidURL(link.URL) {
+				result.addError(linkPrefix+".url", fmt.Sprintf("invalid URL: %s", link.URL))
+			}
 		}
 	}
 
 	// Validate depends_on references
 	for i, task := range tl.Tasks {
+		seen := make(map[string]bool)
 		for _, dep := range task.DependsOn {
 			if !taskIDs[dep] {
-				result.addError(fmt.Sprintf("tasks[%d].depends_on", i), fmt.Sprintf("references unknown task: %s", dep))
+				result.addError(fmt.Sprintf("tasks[%d].depends_on", i), fmt.Sprintf("references unknown task: %s%s", dep, didYouMean(dep, taskIDs)))
+			} else if dep == task.ID {
+				result.addError(fmt.Sprintf("tasks[%d].depends_on", i), fmt.Sprintf("task cannot depend on itself: %s", dep))
+			}
+			if seen[dep] {
+				result.addWarning(fmt.Sprintf("tasks[%d].depends_on", i), fmt.Sprintf("duplicate dependency: %s", dep))
+			}
+			seen[dep] = true
+		}
+	}
+
+	// Validate after references
+	for i, task := range tl.Tasks {
+		for _, id := range task.After {
+			if !taskIDs[id] {
+				result.addError(fmt.Sprintf("tasks[%d].after", i), fmt.Sprintf("references unknown task: %s%s", id, didYouMean(id, taskIDs)))
 			}
 		}
 	}
 
+	// Warn on duplicate titles, once per group at its first occurrence
+	duplicates := tl.DuplicateTitles()
+	for i, task := range tl.Tasks {
+		key := strings.ToLower(strings.TrimSpace(task.Title))
+		if ids, ok := duplicates[key]; ok {
+			result.addWarning(fmt.Sprintf("tasks[%d].title", i), fmt.Sprintf("duplicate title shared by tasks: %s", strings.Join(ids, ", ")))
+			delete(duplicates, key)
+		}
+	}
+
 	// Validate areas
 	areaIDs := make(map[string]bool)
 	for i, area := range tl.Areas {
@@ -101,15 +298,21 @@ func Validate(tl *TaskList) ValidationResult {
 		} else {
 			areaIDs[area.ID] = true
 		}
+		result.checkID(prefix+".id", area.ID)
 		if area.Name == "" {
 			result.addError(prefix+".name", "required field is missing")
 		}
+		result.checkTrimmed(prefix+".name", area.Name)
 	}
 
 	// Validate task area references
 	for i, task := range tl.Tasks {
-		if task.Area != "" && len(tl.Areas) > 0 && !areaIDs[task.Area] {
-			result.addError(fmt.Sprintf("tasks[%d].area", i), fmt.Sprintf("references unknown area: %s", task.Area))
+		checkArea := len(tl.Areas) > 0 || opts.RequireDeclaredAreas
+		if task.Area != "" && checkArea && !areaIDs[task.Area] {
+			result.addError(fmt.Sprintf("tasks[%d].area", i), fmt.Sprintf("references unknown area: %s%s", task.Area, didYouMean(task.Area, areaIDs)))
+		}
+		if allowed, ok := opts.AreaTypePolicy[task.Area]; ok && task.Type != "" && !slices.Contains(allowed, task.Type) {
+			result.addWarning(fmt.Sprintf("tasks[%d].type", i), fmt.Sprintf("type %s is not allowed in area %s (allowed: %s)", task.Type, task.Area, strings.Join(allowed, ", ")))
 		}
 	}
 
@@ -117,13 +320,97 @@ func Validate(tl *TaskList) ValidationResult {
 }
 
 func (r *ValidationResult) addError(field, message string) {
-	r.Errors = append(r.Errors, ValidationError{Field: field, Message: message})
+	r.Errors = append(r.Errors, ValidationError{Field: field, Pointer: jsonPointer(field), Message: message})
 	r.Valid = false
 }
 
+func (r *ValidationResult) addWarning(field, message string) {
+	r.Warnings = append(r.Warnings, ValidationError{Field: field, Pointer: jsonPointer(field), Message: message})
+}
+
+// checkTrimmed adds a warning if value has leading or trailing whitespace.
+// Normalize removes it.
+func (r *ValidationResult) checkTrimmed(field, value string) {
+	if strings.TrimSpace(value) != value {
+		r.addWarning(field, "has leading or trailing whitespace")
+	}
+}
+
+// checkID adds a warning if an ID has leading, trailing, or embedded
+// whitespace. Normalize only removes leading and trailing whitespace.
+func (r *ValidationResult) checkID(field, id string) {
+	if strings.TrimSpace(id) != id {
+		r.addWarning(field, "has leading or trailing whitespace")
+	} else if strings.ContainsFunc(id, unicode.IsSpace) {
+		r.addWarning(field, "contains whitespace")
+	}
+}
+
+// checkLength adds an error if value is longer than max characters.
+// A max of zero disables the check.
+func (r *ValidationResult) checkLength(field, value string, max int) {
+	if n := utf8.RuneCountInString(value); max > 0 && n > max {
+		r.addError(field, fmt.Sprintf("too long: %d characters (max %d)", n, max))
+	}
+}
+
+// jsonPointer converts a field path such as "tasks[0].depends_on" to an
+// RFC 6901 JSON Pointer such as "/tasks/0/dependsOn". Snake case segments
+// are converted to the camelCase names used in the JSON document, except
+// legend keys, which are map keys and kept verbatim.
+func jsonPointer(field string) string {
+	if field == "" {
+		return ""
+	}
+	var sb strings.Builder
+	prev := ""
+	for _, part := range strings.Split(field, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if prev != "legend" {
+			name = snakeToCamel(name)
+		}
+		prev = name
+		sb.WriteString("/" + escapePointerToken(name))
+		for rest != "" {
+			var index string
+			index, rest, _ = strings.Cut(rest, "]")
+			sb.WriteString("/" + escapePointerToken(index))
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return sb.String()
+}
+
+// snakeToCamel converts a snake_case name to camelCase.
+func snakeToCamel(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// escapePointerToken escapes "~" and "/" in a JSON Pointer reference token.
+func escapePointerToken(s string) string {
+	s = strings.ReplaceAll(s, "~", "~0")
+	return strings.ReplaceAll(s, "/", "~1")
+}
+
+// isValidURL reports whether s is an absolute URL with a scheme and host and
+// no whitespace or control characters, which would break Markdown links.
+func isValidURL(s string) bool {
+	if strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0 {
+		return false
+	}
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
 func isValidStatus(s Status) bool {
 	switch s {
-	case StatusCompleted, StatusInProgress, StatusPlanned, StatusFuture:
+	case StatusCompleted, StatusInProgress, StatusBlocked, StatusPlanned, StatusFuture:
 		return true
 	}
 	return false
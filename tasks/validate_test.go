@@ -0,0 +1,396 @@
+package tasks
+
+import (
+	"strings"
+	"testing"
+)
+
+type stubRegistry map[string]bool
+
+func (r stubRegistry) IsValidName(name string) bool {
+	return r[name]
+}
+
+func TestValidateWithTypeRegistry(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusCompleted, Type: "Infra"},
+		},
+	}
+
+	if result := Validate(tl); result.Valid {
+		t.Error("Expected custom type to be invalid with the default registry")
+	}
+
+	result := ValidateWith(tl, ValidateOptions{TypeRegistry: stubRegistry{"Infra": true}})
+	if !result.Valid {
+		t.Errorf("Expected custom type to be valid with a custom registry, got %v", result.Errors)
+	}
+
+	result = ValidateWith(tl, ValidateOptions{TypeRegistry: stubRegistry{"Added": true}})
+	if result.Valid {
+		t.Error("Expected type not in custom registry to be invalid")
+	}
+}
+
+func TestValidateLinks(t *testing.T) {
+	tests := []struct {
+		name      string
+		link      Link
+		wantField string
+	}{
+		{name: "valid", link: Link{Label: "Design", URL: "https://example.com/design", Rel: "design"}},
+		{name: "missing label", link: Link{URL: "https://example.com"}, wantField: "tasks[0].links[0].label"},
+		{name: "missing url", link: Link{Label: "PR"}, wantField: "tasks[0].links[0].url"},
+		{name: "relative url", link: Link{Label: "PR", URL: "pull/12"}, wantField: "tasks[0].links[0].url"},
+		{name: "url with space", link: Link{Label: "Wiki", URL: "https://example.com/wiki/Foo_(bar) x"}, wantField: "tasks[0].links[0].url"},
+		{name: "url with control character", link: Link{Label: "Wiki", URL: "https://example.com/\tx"}, wantField: "tasks[0].links[0].url"},
+		{name: "url with parentheses", link: Link{Label: "Wiki", URL: "https://example.com/wiki/Foo_(bar)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := &TaskList{
+				IRVersion: "1.0",
+				Project:   "test",
+				Tasks: []Task{
+					{ID: "task-1", Title: "Feature", Status: StatusPlanned, Links: []Link{tt.link}},
+				},
+			}
+			result := Validate(tl)
+			if tt.wantField == "" {
+				if !result.Valid {
+					t.Errorf("Expected valid, got %v", result.Errors)
+				}
+				return
+			}
+			if result.Valid || result.Errors[0].Field != tt.wantField {
+				t.Errorf("Expected error on %s, got %v", tt.wantField, result.Errors)
+			}
+		})
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"ir_version", "/irVersion"},
+		{"project", "/project"},
+		{"tasks[0].id", "/tasks/0/id"},
+		{"tasks[2].depends_on", "/tasks/2/dependsOn"},
+		{"tasks[1].links[3].url", "/tasks/1/links/3/url"},
+		{"legend.a/b~c", "/legend/a~1b~0c"},
+		{"legend.in_progress.emoji", "/legend/in_progress/emoji"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := jsonPointer(tt.field); got != tt.want {
+				t.Errorf("jsonPointer(%q) = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrorPointer(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusPlanned, DependsOn: []string{"missing"}},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %v", result.Errors)
+	}
+	if result.Errors[0].Pointer != "/tasks/0/dependsOn" {
+		t.Errorf("Pointer = %q, want %q", result.Errors[0].Pointer, "/tasks/0/dependsOn")
+	}
+}
+
+func TestValidateLegend(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Legend: map[Status]LegendEntry{
+			StatusCompleted: {Emoji: "✔", Description: "Done"},
+			StatusPlanned:   {Description: "Planned"},
+			StatusFuture:    {Emoji: "?"},
+		},
+	}
+
+	result := Validate(tl)
+	if !result.Valid {
+		t.Errorf("Expected empty legend fields to be warnings only, got %v", result.Errors)
+	}
+	wantWarnings := []string{"legend.future.description", "legend.planned.emoji"}
+	if len(result.Warnings) != len(wantWarnings) {
+		t.Fatalf("Expected %d warnings, got %v", len(wantWarnings), result.Warnings)
+	}
+	for i, want := range wantWarnings {
+		if result.Warnings[i].Field != want {
+			t.Errorf("Warnings[%d].Field = %q, want %q", i, result.Warnings[i].Field, want)
+		}
+	}
+
+	tl.Legend["in_progress"] = LegendEntry{Emoji: "🚧", Description: "In Progress"}
+	result = Validate(tl)
+	if !result.Valid {
+		t.Errorf("Expected unknown legend key to be a warning only, got %v", result.Errors)
+	}
+	found := false
+	for _, w := range result.Warnings {
+		if w.Field == "legend.in_progress" && strings.Contains(w.Message, "did you mean inProgress?") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected warning with suggestion for unknown legend key, got %v", result.Warnings)
+	}
+}
+
+func TestValidateRequireDeclaredAreas(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusPlanned, Area: "core"},
+			{ID: "task-2", Title: "Other", Status: StatusPlanned},
+		},
+	}
+
+	if result := Validate(tl); !result.Valid {
+		t.Errorf("Expected undeclared areas to be allowed by default, got %v", result.Errors)
+	}
+
+	opts := ValidateOptions{RequireDeclaredAreas: true}
+	result := ValidateWith(tl, opts)
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0].Field != "tasks[0].area" {
+		t.Errorf("Expected error on tasks[0].area, got %v", result.Errors)
+	}
+
+	tl.Areas = []Area{{ID: "core", Name: "Core"}}
+	if result := ValidateWith(tl, opts); !result.Valid {
+		t.Errorf("Expected declared area to be valid, got %v", result.Errors)
+	}
+}
+
+func TestValidateOwners(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusPlanned, Owners: []string{"alice", " ", "alice"}},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "tasks[0].owners[1]" {
+		t.Errorf("Expected error on tasks[0].owners[1], got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "tasks[0].owners[2]" {
+		t.Errorf("Expected warning on tasks[0].owners[2], got %v", result.Warnings)
+	}
+}
+
+func TestValidateDuplicateDependsOn(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Base", Status: StatusPlanned},
+			{ID: "task-2", Title: "Feature", Status: StatusPlanned, DependsOn: []string{"task-1", "task-1"}},
+		},
+	}
+
+	result := Validate(tl)
+	if !result.Valid {
+		t.Errorf("Duplicate dependencies should not invalidate, got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "tasks[1].depends_on" {
+		t.Errorf("Expected warning on tasks[1].depends_on, got %v", result.Warnings)
+	}
+}
+
+func TestValidateWithLimits(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{
+				ID:          "task-1",
+				Title:       "Feature",
+				Description: "Héllo wörld",
+				Status:      StatusPlanned,
+				Subtasks:    []Subtask{{Description: "one"}, {Description: "two"}, {Description: "three"}},
+			},
+		},
+	}
+
+	if result := Validate(tl); !result.Valid {
+		t.Fatalf("Limits should be off by default, got %v", result.Errors)
+	}
+
+	result := ValidateWith(tl, ValidateOptions{MaxSubtasks: 2, MaxTextLength: 10})
+	var fields []string
+	for _, e := range result.Errors {
+		fields = append(fields, e.Field)
+	}
+	want := []string{"tasks[0].description", "tasks[0].subtasks"}
+	if !equalIDs(fields, want) {
+		t.Errorf("Error fields = %v, want %v", fields, want)
+	}
+
+	if result := ValidateWith(tl, ValidateOptions{MaxSubtasks: 3, MaxTextLength: 11}); !result.Valid {
+		t.Errorf("Values at the limit should be valid, got %v", result.Errors)
+	}
+}
+
+func TestValidateAfter(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Base", Status: StatusPlanned},
+			{ID: "task-2", Title: "Feature", Status: StatusPlanned, After: []string{"task-1", "task-9"}},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "tasks[1].after" {
+		t.Errorf("Expected error on tasks[1].after, got %v", result.Errors)
+	}
+}
+
+func TestValidateSelfDependency(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Feature", Status: StatusPlanned, DependsOn: []string{"task-1"}},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "tasks[0].depends_on" {
+		t.Fatalf("Expected one error on tasks[0].depends_on, got %v", result.Errors)
+	}
+	if !strings.Contains(result.Errors[0].Message, "itself") {
+		t.Errorf("Expected self-dependency message, got %q", result.Errors[0].Message)
+	}
+}
+
+func TestValidatePercent(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Active", Status: StatusInProgress, Percent: 60},
+			{ID: "task-2", Title: "Over", Status: StatusInProgress, Percent: 120},
+			{ID: "task-3", Title: "Planned", Status: StatusPlanned, Percent: 10},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "tasks[1].percent" {
+		t.Errorf("Expected error on tasks[1].percent, got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "tasks[2].percent" {
+		t.Errorf("Expected warning on tasks[2].percent, got %v", result.Warnings)
+	}
+}
+
+func TestValidateHorizon(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Tasks: []Task{
+			{ID: "task-1", Title: "Now", Status: StatusInProgress, Horizon: HorizonNow},
+			{ID: "task-2", Title: "Soon", Status: StatusPlanned, Horizon: "soon"},
+			{ID: "task-3", Title: "Unset", Status: StatusPlanned},
+		},
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "tasks[1].horizon" {
+		t.Errorf("Expected error on tasks[1].horizon, got %v", result.Errors)
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	data := []byte(`{"irVersion": "1.0", "project": "p", "metadata": {"team": "platform", "": "x"}}`)
+	tl, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if tl.Metadata["team"] != "platform" {
+		t.Errorf("Metadata[team] = %q, want platform", tl.Metadata["team"])
+	}
+
+	result := Validate(tl)
+	if len(result.Errors) != 1 || result.Errors[0].Field != "metadata" {
+		t.Errorf("Expected error on metadata, got %v", result.Errors)
+	}
+
+	out, err := ToJSON(tl)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"team": "platform"`) {
+		t.Errorf("ToJSON() should round-trip metadata, got %s", out)
+	}
+}
+
+func TestValidateAreaTypePolicy(t *testing.T) {
+	tl := &TaskList{
+		IRVersion: "1.0",
+		Project:   "test",
+		Areas:     []Area{{ID: "docs", Name: "Docs"}, {ID: "core", Name: "Core"}},
+		Tasks: []Task{
+			{ID: "task-1", Title: "Guide", Status: StatusPlanned, Area: "docs", Type: "Added"},
+			{ID: "task-2", Title: "Typo", Status: StatusPlanned, Area: "docs", Type: "Fixed"},
+			{ID: "task-3", Title: "Untyped", Status: StatusPlanned, Area: "docs"},
+			{ID: "task-4", Title: "Bug", Status: StatusPlanned, Area: "core", Type: "Fixed"},
+		},
+	}
+
+	if result := Validate(tl); len(result.Warnings) != 0 {
+		t.Errorf("No policy should mean no warnings, got %v", result.Warnings)
+	}
+
+	result := ValidateWith(tl, ValidateOptions{AreaTypePolicy: map[string][]string{"docs": {"Added", "Changed"}}})
+	if !result.Valid {
+		t.Errorf("Policy violations should not invalidate, got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "tasks[1].type" {
+		t.Errorf("Expected warning on tasks[1].type, got %v", result.Warnings)
+	}
+}
+
+func TestValidateWarnEmpty(t *testing.T) {
+	tl := &TaskList{IRVersion: "1.0", Project: "test"}
+
+	if result := Validate(tl); len(result.Warnings) != 0 {
+		t.Errorf("Empty task lists should not warn by default, got %v", result.Warnings)
+	}
+
+	result := ValidateWith(tl, ValidateOptions{WarnEmpty: true})
+	if !result.Valid {
+		t.Errorf("Empty task list should still be valid, got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Message != "task list contains no content" {
+		t.Errorf("Expected a single no-content warning, got %v", result.Warnings)
+	}
+
+	tl.Areas = []Area{{ID: "core", Name: "Core"}}
+	if result := ValidateWith(tl, ValidateOptions{WarnEmpty: true}); len(result.Warnings) != 0 {
+		t.Errorf("Task list with areas should not warn, got %v", result.Warnings)
+	}
+}
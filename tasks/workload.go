@@ -0,0 +1,53 @@
+package tasks
+
+import "sort"
+
+// Workload summarizes the open work assigned to one owner.
+type Workload struct {
+	Owner         string  // Owner name, or "_unassigned"
+	InProgress    int     // Number of in-progress tasks
+	Planned       int     // Number of planned tasks
+	EstimatedDays float64 // Sum of EstimatedDays over tasks that are not completed
+}
+
+// Workloads returns the workload of each owner from TasksByOwner, including
+// the "_unassigned" bucket. Owners whose tasks are all completed are omitted.
+// Results are sorted by descending in-progress count, then descending planned
+// count, then owner name, with "_unassigned" last.
+func (tl *TaskList) Workloads() []Workload {
+	var result []Workload
+	for owner, ownerTasks := range tl.TasksByOwner() {
+		w := Workload{Owner: owner}
+		open := false
+		for _, task := range ownerTasks {
+			switch task.Status {
+			case StatusInProgress:
+				w.InProgress++
+			case StatusPlanned:
+				w.Planned++
+			}
+			if !task.Status.IsTerminal() {
+				w.EstimatedDays += task.EstimatedDays
+				open = true
+			}
+		}
+		if open {
+			result = append(result, w)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if (a.Owner == "_unassigned") != (b.Owner == "_unassigned") {
+			return b.Owner == "_unassigned"
+		}
+		if a.InProgress != b.InProgress {
+			return a.InProgress > b.InProgress
+		}
+		if a.Planned != b.Planned {
+			return a.Planned > b.Planned
+		}
+		return a.Owner < b.Owner
+	})
+	return result
+}
@@ -0,0 +1,29 @@
+package tasks
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWorkloads(t *testing.T) {
+	tl := &TaskList{
+		Tasks: []Task{
+			{ID: "1", Status: StatusInProgress, Owners: []string{"bob"}, EstimatedDays: 2},
+			{ID: "2", Status: StatusPlanned, Owners: []string{"alice", "bob"}, EstimatedDays: 1},
+			{ID: "3", Status: StatusInProgress, Owners: []string{"alice"}},
+			{ID: "4", Status: StatusInProgress},
+			{ID: "5", Status: StatusInProgress},
+			{ID: "6", Status: StatusCompleted, Owners: []string{"carol"}, EstimatedDays: 5},
+			{ID: "7", Status: StatusCompleted, Owners: []string{"bob"}, EstimatedDays: 5},
+		},
+	}
+
+	want := []Workload{
+		{Owner: "alice", InProgress: 1, Planned: 1, EstimatedDays: 1},
+		{Owner: "bob", InProgress: 1, Planned: 1, EstimatedDays: 3},
+		{Owner: "_unassigned", InProgress: 2},
+	}
+	if got := tl.Workloads(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Workloads() = %+v, want %+v", got, want)
+	}
+}